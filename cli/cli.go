@@ -15,15 +15,30 @@ import (
 	"github.com/figment-networks/mina-indexer/store"
 )
 
+// commandOptions contains the optional arguments of individual commands
+type commandOptions struct {
+	epoch     int
+	validator string
+	fee       float64
+	file      string
+	id        int
+}
+
 // Run executes the command line interface
 func Run() {
 	var configPath string
 	var runCommand string
 	var showVersion bool
+	var opts commandOptions
 
 	flag.BoolVar(&showVersion, "v", false, "Show application version")
 	flag.StringVar(&configPath, "config", "", "Path to config")
 	flag.StringVar(&runCommand, "cmd", "", "Command to run")
+	flag.IntVar(&opts.epoch, "epoch", -1, "Epoch number")
+	flag.StringVar(&opts.validator, "validator", "", "Validator public key")
+	flag.Float64Var(&opts.fee, "fee", 0, "Validator fee percentage")
+	flag.StringVar(&opts.file, "file", "", "Path to input or output file")
+	flag.IntVar(&opts.id, "id", 0, "Record ID")
 	flag.Parse()
 
 	if showVersion {
@@ -47,12 +62,12 @@ func Run() {
 		terminate("Command is required")
 	}
 
-	if err := startCommand(cfg, runCommand); err != nil {
+	if err := startCommand(cfg, runCommand, opts); err != nil {
 		terminate(err)
 	}
 }
 
-func startCommand(cfg *config.Config, name string) error {
+func startCommand(cfg *config.Config, name string, opts commandOptions) error {
 	switch name {
 	case "migrate", "migrate:up", "migrate:down", "migrate:redo":
 		return startMigrations(name, cfg)
@@ -68,6 +83,18 @@ func startCommand(cfg *config.Config, name string) error {
 		return startStatus(cfg)
 	case "update-identity":
 		return runUpdateIdentity(cfg)
+	case "rewards:export":
+		return runRewardsExport(cfg, opts)
+	case "ledger:verify":
+		return runLedgerVerify(cfg)
+	case "ledger:import":
+		return runLedgerImport(cfg, opts)
+	case "webhooks:create":
+		return runWebhooksCreate(cfg, opts)
+	case "webhooks:list":
+		return runWebhooksList(cfg)
+	case "webhooks:delete":
+		return runWebhooksDelete(cfg, opts)
 	default:
 		return fmt.Errorf("%s is not a valid command", name)
 	}
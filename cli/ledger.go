@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/client/archive"
+	"github.com/figment-networks/mina-indexer/config"
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/mapper"
+	"github.com/figment-networks/mina-indexer/store"
+)
+
+func runLedgerImport(cfg *config.Config, opts commandOptions) error {
+	if opts.epoch < 0 {
+		return errors.New("epoch is not provided")
+	}
+	if opts.file == "" {
+		return errors.New("file is not provided")
+	}
+
+	f, err := os.Open(opts.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	records := []archive.StakingInfo{}
+	if err := json.NewDecoder(f).Decode(&records); err != nil {
+		return fmt.Errorf("ledger file decode failed: %v", err)
+	}
+
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	existing, err := db.Staking.FindLedger(opts.epoch)
+	if err != nil && err != store.ErrNotFound {
+		return err
+	}
+
+	// Only replace ledgers that are incomplete
+	if existing != nil {
+		if err := db.Staking.VerifyLedger(existing); err != nil {
+			return err
+		}
+		if existing.Verified {
+			return fmt.Errorf("ledger for epoch %d already exists", opts.epoch)
+		}
+	}
+
+	data, err := mapper.EpochLedger(opts.epoch, model.LedgerTypeCurrent, records)
+	if err != nil {
+		return err
+	}
+
+	// Existing ledger is removed in the same transaction, a failed import keeps it
+	if existing != nil {
+		err = db.Staking.ReplaceLedger(existing, data.Ledger, data.Entries)
+	} else {
+		err = db.Staking.ImportLedger(data.Ledger, data.Entries)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger := log.
+		WithField("epoch", data.Ledger.Epoch).
+		WithField("entries", data.Ledger.EntriesCount).
+		WithField("staked", data.Ledger.StakedAmount)
+
+	if !data.Ledger.Verified {
+		logger.WithField("error", *data.Ledger.VerifyError).Warn("ledger imported, verification failed")
+		return nil
+	}
+
+	logger.Info("ledger imported")
+	return nil
+}
+
+func runLedgerVerify(cfg *config.Config) error {
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ledgers, err := db.Staking.AllLedgers()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for idx := range ledgers {
+		ledger := &ledgers[idx]
+
+		logger := log.
+			WithField("epoch", ledger.Epoch).
+			WithField("type", ledger.Type).
+			WithField("entries", ledger.EntriesCount)
+
+		if err := db.Staking.VerifyLedger(ledger); err != nil {
+			return err
+		}
+		if !ledger.Verified {
+			logger.WithField("error", *ledger.VerifyError).Error("ledger verification failed")
+			failed++
+			continue
+		}
+
+		logger.Info("ledger verified")
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d ledgers failed verification", failed, len(ledgers))
+	}
+	return nil
+}
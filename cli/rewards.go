@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/config"
+)
+
+func runRewardsExport(cfg *config.Config, opts commandOptions) error {
+	if opts.validator == "" {
+		return errors.New("validator is not provided")
+	}
+	if opts.epoch < 0 {
+		return errors.New("epoch is not provided")
+	}
+
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	block, err := db.Blocks.Recent()
+	if err != nil {
+		return err
+	}
+	if opts.epoch >= block.Epoch {
+		return fmt.Errorf("epoch %d is not completed yet", opts.epoch)
+	}
+
+	summary, err := db.Rewards.CalculateAndStore(opts.validator, opts.epoch, opts.fee)
+	if err != nil {
+		return err
+	}
+
+	log.
+		WithField("validator", summary.Validator).
+		WithField("epoch", summary.Epoch).
+		WithField("blocks", summary.Income.BlocksProduced).
+		WithField("rewards", summary.TotalRewards).
+		WithField("payouts", len(summary.Payouts)).
+		Info("rewards calculated")
+
+	var out io.Writer = os.Stdout
+	if opts.file != "" {
+		f, err := os.Create(opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	writer := csv.NewWriter(out)
+	writer.Write([]string{"validator", "epoch", "delegator", "balance", "share", "reward", "validator_fee"})
+
+	for _, p := range summary.Payouts {
+		writer.Write([]string{
+			p.Validator,
+			fmt.Sprintf("%d", p.Epoch),
+			p.Delegator,
+			p.Balance.String(),
+			fmt.Sprintf("%f", p.Share),
+			p.Reward.String(),
+			fmt.Sprintf("%f", p.ValidatorFee),
+		})
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
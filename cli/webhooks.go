@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/config"
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// webhookInput contains the webhook subscription file fields
+type webhookInput struct {
+	URL       string   `json:"url"`
+	Secret    string   `json:"secret"`
+	Events    []string `json:"events"`
+	Account   *string  `json:"account"`
+	Validator *string  `json:"validator"`
+	TxType    *string  `json:"tx_type"`
+	MinAmount string   `json:"min_amount"` // in MINA
+}
+
+func runWebhooksCreate(cfg *config.Config, opts commandOptions) error {
+	if opts.file == "" {
+		return errors.New("file is not provided")
+	}
+
+	f, err := os.Open(opts.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	input := webhookInput{}
+	if err := json.NewDecoder(f).Decode(&input); err != nil {
+		return fmt.Errorf("webhook file decode failed: %v", err)
+	}
+
+	sub := &model.WebhookSubscription{
+		URL:       input.URL,
+		Secret:    input.Secret,
+		Events:    input.Events,
+		Account:   input.Account,
+		Validator: input.Validator,
+		TxType:    input.TxType,
+	}
+	if input.MinAmount != "" {
+		if sub.MinAmount, err = types.ParseMINA(input.MinAmount); err != nil {
+			return fmt.Errorf("invalid min amount: %v", err)
+		}
+	}
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := db.Webhooks.CreateSubscription(sub); err != nil {
+		return err
+	}
+
+	log.
+		WithField("id", sub.ID).
+		WithField("url", sub.URL).
+		Info("webhook created")
+
+	return nil
+}
+
+func runWebhooksList(cfg *config.Config) error {
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	subscriptions, err := db.Webhooks.Subscriptions()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subscriptions {
+		data, err := json.Marshal(sub)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+
+	return nil
+}
+
+func runWebhooksDelete(cfg *config.Config, opts commandOptions) error {
+	if opts.id <= 0 {
+		return errors.New("id is not provided")
+	}
+
+	db, err := initStore(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.Webhooks.FindSubscription(opts.id); err != nil {
+		return err
+	}
+	if err := db.Webhooks.DeleteSubscription(opts.id); err != nil {
+		return err
+	}
+
+	log.WithField("id", opts.id).Info("webhook deleted")
+	return nil
+}
@@ -74,11 +74,94 @@ func startCleanupWorker(wg *sync.WaitGroup, cfg *config.Config, db *store.Store)
 	return cancel
 }
 
+func startSupplyWorker(wg *sync.WaitGroup, cfg *config.Config, db *store.Store) context.CancelFunc {
+	wg.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := time.NewTicker(cfg.SupplyDuration())
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			wg.Done()
+		}()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := worker.RunSupply(cfg, db); err != nil {
+					log.WithError(err).Error("supply failed")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
+
+func startSnarkPoolWorker(wg *sync.WaitGroup, cfg *config.Config, db *store.Store) context.CancelFunc {
+	wg.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	client := graph.NewDefaultClient(cfg.MinaEndpoint)
+	ticker := time.NewTicker(cfg.SnarkPoolDuration())
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			wg.Done()
+		}()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := worker.RunSnarkPool(cfg, db, client); err != nil {
+					log.WithError(err).Error("snark pool failed")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
+
+func startWebhookWorker(wg *sync.WaitGroup, cfg *config.Config, db *store.Store) context.CancelFunc {
+	wg.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	client := worker.NewWebhookClient()
+	ticker := time.NewTicker(cfg.WebhookDuration())
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			wg.Done()
+		}()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := worker.RunWebhooks(cfg, db, client); err != nil {
+					log.WithError(err).Error("webhooks failed")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cancel
+}
+
 func startWorker(cfg *config.Config) error {
 	log.Info("using mina graph endpoint: ", cfg.MinaEndpoint)
 	log.Info("using mina archive endpoint: ", cfg.ArchiveEndpoint)
 	log.Info("sync will run every: ", cfg.SyncInterval)
 	log.Info("cleanup will run every: ", cfg.CleanupInterval)
+	log.Info("supply will run every: ", cfg.SupplyInterval)
+	log.Info("snark pool will run every: ", cfg.SnarkPoolInterval)
+	log.Info("webhooks will run every: ", cfg.WebhookInterval)
 
 	db, err := initStore(cfg)
 	if err != nil {
@@ -90,12 +173,18 @@ func startWorker(cfg *config.Config) error {
 
 	cancelSync := startSyncWorker(wg, cfg, db)
 	cancelCleanup := startCleanupWorker(wg, cfg, db)
+	cancelSupply := startSupplyWorker(wg, cfg, db)
+	cancelSnarkPool := startSnarkPoolWorker(wg, cfg, db)
+	cancelWebhook := startWebhookWorker(wg, cfg, db)
 
 	s := <-initSignals()
 
 	log.Info("received signal: ", s)
 	cancelSync()
 	cancelCleanup()
+	cancelSupply()
+	cancelSnarkPool()
+	cancelWebhook()
 
 	wg.Wait()
 	return nil
@@ -3,6 +3,7 @@ package archive
 const (
 	LedgerTypeCurrent = "current"
 	LedgerTypeStaged  = "staged"
+	LedgerTypeNext    = "next"
 )
 
 type BlocksRequest struct {
@@ -273,3 +273,25 @@ func (c Client) GetPendingTransactions() ([]PendingTransaction, error) {
 
 	return result.Transactions, nil
 }
+
+// GetSnarkPool returns completed snark work available for purchase
+func (c Client) GetSnarkPool() ([]CompletedWork, error) {
+	var result struct {
+		Works []CompletedWork `json:"snarkPool"`
+	}
+	if err := c.Query(querySnarkPool, &result); err != nil {
+		return nil, err
+	}
+	return result.Works, nil
+}
+
+// GetPendingSnarkWork returns snark work that still needs to be done
+func (c Client) GetPendingSnarkWork() ([]PendingSnarkWork, error) {
+	var result struct {
+		Works []PendingSnarkWork `json:"pendingSnarkWork"`
+	}
+	if err := c.Query(queryPendingSnarkWork, &result); err != nil {
+		return nil, err
+	}
+	return result.Works, nil
+}
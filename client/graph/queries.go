@@ -37,6 +37,18 @@ var (
 						epochCount
 						slot
 						blockHeight
+						stakingEpochData {
+							ledger {
+								hash
+								totalCurrency
+							}
+						}
+						nextEpochData {
+							ledger {
+								hash
+								totalCurrency
+							}
+						}
 					}
 				}
 			}
@@ -100,6 +112,7 @@ var (
 				slot
 				stakingEpochData {
 					ledger {
+						hash
 						totalCurrency
 					}
 					epochLength
@@ -144,6 +157,24 @@ var (
 			}
 		}`
 
+	querySnarkPool = `
+		query {
+			snarkPool {
+				fee
+				prover
+				workIds
+			}
+		}`
+
+	queryPendingSnarkWork = `
+		query {
+			pendingSnarkWork {
+				workBundle {
+					workId
+				}
+			}
+		}`
+
 	queryPendingTx = `
 		query {
 			pooledUserCommands {
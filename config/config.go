@@ -25,31 +25,43 @@ var (
 	errSyncIntervalInvalid     = errors.New("Sync interval is invalid")
 	errCleanupIntervalRequired = errors.New("Cleanup interval is required")
 	errCleanupIntervalInvalid  = errors.New("Cleanup interval is invalid")
+	errSupplyIntervalRequired  = errors.New("Supply interval is required")
+	errSupplyIntervalInvalid   = errors.New("Supply interval is invalid")
+	errSnarkPoolRequired       = errors.New("Snark pool interval is required")
+	errSnarkPoolInvalid        = errors.New("Snark pool interval is invalid")
+	errWebhookRequired         = errors.New("Webhook interval is required")
+	errWebhookInvalid          = errors.New("Webhook interval is invalid")
 )
 
 // Config holds the configration data
 type Config struct {
-	AppEnv           string `json:"app_env" envconfig:"APP_ENV" default:"development"`
-	MinaEndpoint     string `json:"mina_endpoint" envconfig:"MINA_ENDPOINT"`
-	ArchiveEndpoint  string `json:"archive_endpoint" envconfig:"ARCHIVE_ENDPOINT"`
-	GenesisFile      string `json:"genesis_file" envconfig:"GENESIS_FILE"`
-	IdentityFile     string `json:"identity_file" envconfig:"IDENTITY_FILE"`
-	ServerAddr       string `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
-	ServerPort       int    `json:"server_port" envconfig:"SERVER_PORT" default:"8080"`
-	SyncInterval     string `json:"sync_interval" envconfig:"SYNC_INTERVAL" default:"60s"`
-	CleanupInterval  string `json:"cleanup_interval" envconfig:"CLEANUP_INTERVAL" default:"10m"`
-	CleanupThreshold int    `json:"cleanup_threshold" envconfig:"CLEANUP_THRESHOLD" default:"1000"`
-	DatabaseURL      string `json:"database_url" envconfig:"DATABASE_URL"`
-	DumpDir          string `json:"dump_dir" envconfig:"DUMP_DIR"`
-	LogLevel         string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
-	LogFormat        string `json:"log_format" envconfig:"LOG_FORMAT" default:"text"`
-	RollbarToken     string `json:"rollbar_token" envconfig:"ROLLBAR_TOKEN"`
-	RollbarNamespace string `json:"rollbar_namespace" envconfig:"ROLLBAR_NAMESPACE"`
+	AppEnv            string `json:"app_env" envconfig:"APP_ENV" default:"development"`
+	MinaEndpoint      string `json:"mina_endpoint" envconfig:"MINA_ENDPOINT"`
+	ArchiveEndpoint   string `json:"archive_endpoint" envconfig:"ARCHIVE_ENDPOINT"`
+	GenesisFile       string `json:"genesis_file" envconfig:"GENESIS_FILE"`
+	IdentityFile      string `json:"identity_file" envconfig:"IDENTITY_FILE"`
+	ServerAddr        string `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
+	ServerPort        int    `json:"server_port" envconfig:"SERVER_PORT" default:"8080"`
+	SyncInterval      string `json:"sync_interval" envconfig:"SYNC_INTERVAL" default:"60s"`
+	CleanupInterval   string `json:"cleanup_interval" envconfig:"CLEANUP_INTERVAL" default:"10m"`
+	CleanupThreshold  int    `json:"cleanup_threshold" envconfig:"CLEANUP_THRESHOLD" default:"1000"`
+	SupplyInterval    string `json:"supply_interval" envconfig:"SUPPLY_INTERVAL" default:"5m"`
+	SnarkPoolInterval string `json:"snark_pool_interval" envconfig:"SNARK_POOL_INTERVAL" default:"1m"`
+	WebhookInterval   string `json:"webhook_interval" envconfig:"WEBHOOK_INTERVAL" default:"10s"`
+	DatabaseURL       string `json:"database_url" envconfig:"DATABASE_URL"`
+	DumpDir           string `json:"dump_dir" envconfig:"DUMP_DIR"`
+	LogLevel          string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
+	LogFormat         string `json:"log_format" envconfig:"LOG_FORMAT" default:"text"`
+	RollbarToken      string `json:"rollbar_token" envconfig:"ROLLBAR_TOKEN"`
+	RollbarNamespace  string `json:"rollbar_namespace" envconfig:"ROLLBAR_NAMESPACE"`
 
 	HistoricalLimit uint `json:"historical_limit" envconfig:"HISTORICAL_LIMIT" default:"290"`
 
-	syncDuration    time.Duration
-	cleanupDuration time.Duration
+	syncDuration      time.Duration
+	cleanupDuration   time.Duration
+	supplyDuration    time.Duration
+	snarkPoolDuration time.Duration
+	webhookDuration   time.Duration
 }
 
 // Validate returns an error if config is invalid
@@ -87,6 +99,33 @@ func (c *Config) Validate() error {
 	}
 	c.cleanupDuration = d
 
+	if c.SupplyInterval == "" {
+		return errSupplyIntervalRequired
+	}
+	d, err = time.ParseDuration(c.SupplyInterval)
+	if err != nil {
+		return errSupplyIntervalInvalid
+	}
+	c.supplyDuration = d
+
+	if c.SnarkPoolInterval == "" {
+		return errSnarkPoolRequired
+	}
+	d, err = time.ParseDuration(c.SnarkPoolInterval)
+	if err != nil {
+		return errSnarkPoolInvalid
+	}
+	c.snarkPoolDuration = d
+
+	if c.WebhookInterval == "" {
+		return errWebhookRequired
+	}
+	d, err = time.ParseDuration(c.WebhookInterval)
+	if err != nil {
+		return errWebhookInvalid
+	}
+	c.webhookDuration = d
+
 	return nil
 }
 
@@ -115,6 +154,21 @@ func (c *Config) CleanupDuration() time.Duration {
 	return c.cleanupDuration
 }
 
+// SupplyDuration returns the parsed duration for the supply pipeline
+func (c *Config) SupplyDuration() time.Duration {
+	return c.supplyDuration
+}
+
+// SnarkPoolDuration returns the parsed duration for the snark pool pipeline
+func (c *Config) SnarkPoolDuration() time.Duration {
+	return c.snarkPoolDuration
+}
+
+// WebhookDuration returns the parsed duration for the webhook deliveries pipeline
+func (c *Config) WebhookDuration() time.Duration {
+	return c.webhookDuration
+}
+
 // New returns a new config
 func New() *Config {
 	return &Config{}
@@ -17,6 +17,9 @@ func TestFromEnv(t *testing.T) {
 	assert.Equal(t, "60s", config.SyncInterval)
 	assert.Equal(t, "10m", config.CleanupInterval)
 	assert.Equal(t, 1000, config.CleanupThreshold)
+	assert.Equal(t, "5m", config.SupplyInterval)
+	assert.Equal(t, "1m", config.SnarkPoolInterval)
+	assert.Equal(t, "10s", config.WebhookInterval)
 }
 
 func TestFromFile(t *testing.T) {
@@ -60,4 +63,31 @@ func TestValidate(t *testing.T) {
 
 	config.CleanupInterval = "10s"
 	assert.NotEqual(t, config.Validate(), errCleanupIntervalInvalid)
+
+	config.SupplyInterval = ""
+	assert.Equal(t, config.Validate(), errSupplyIntervalRequired)
+
+	config.SupplyInterval = "5min"
+	assert.Equal(t, config.Validate(), errSupplyIntervalInvalid)
+
+	config.SupplyInterval = "5m"
+	assert.NotEqual(t, config.Validate(), errSupplyIntervalInvalid)
+
+	config.SnarkPoolInterval = ""
+	assert.Equal(t, config.Validate(), errSnarkPoolRequired)
+
+	config.SnarkPoolInterval = "1min"
+	assert.Equal(t, config.Validate(), errSnarkPoolInvalid)
+
+	config.SnarkPoolInterval = "1m"
+	assert.NotEqual(t, config.Validate(), errSnarkPoolInvalid)
+
+	config.WebhookInterval = ""
+	assert.Equal(t, config.Validate(), errWebhookRequired)
+
+	config.WebhookInterval = "10sec"
+	assert.Equal(t, config.Validate(), errWebhookInvalid)
+
+	config.WebhookInterval = "10s"
+	assert.NoError(t, config.Validate())
 }
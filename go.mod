@@ -3,10 +3,12 @@ module github.com/figment-networks/mina-indexer
 go 1.14
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.0
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/figment-networks/indexing-engine v0.1.14
-	github.com/gin-gonic/gin v1.6.3
+	github.com/gin-gonic/gin v1.7.7
 	github.com/go-sql-driver/mysql v1.5.0 // indirect
+	github.com/gorilla/websocket v1.4.2
 	github.com/jessevdk/go-assets v0.0.0-20160921144138-4f4301a06e15
 	github.com/jinzhu/gorm v1.9.12
 	github.com/kelseyhightower/envconfig v1.4.0
@@ -16,5 +18,4 @@ require (
 	github.com/rollbar/rollbar-go v1.2.0
 	github.com/sirupsen/logrus v1.7.0
 	github.com/stretchr/testify v1.6.1
-	golang.org/x/crypto v0.0.0-20200302210943-78000ba7a073 // indirect
 )
@@ -6,10 +6,13 @@ import (
 
 // Data contains all the records processed for a height
 type Data struct {
-	Block        *model.Block
-	Validator    *model.Validator
-	Accounts     []model.Account
-	Snarkers     []model.Snarker
-	Transactions []model.Transaction
-	SnarkJobs    []model.SnarkJob
+	Block             *model.Block
+	StakingLedgerHash string
+	Validator         *model.Validator
+	Accounts          []model.Account
+	AccountBalances   []model.AccountBalance
+	Snarkers          []model.Snarker
+	Transactions      []model.Transaction
+	Tokens            []model.Token
+	SnarkJobs         []model.SnarkJob
 }
@@ -1,6 +1,7 @@
 package indexing
 
 import (
+	"github.com/figment-networks/mina-indexer/model"
 	"github.com/figment-networks/mina-indexer/store"
 	log "github.com/sirupsen/logrus"
 )
@@ -15,6 +16,24 @@ func Finalize(db *store.Store, data *Data) error {
 		return err
 	}
 
+	log.WithField("epoch", data.Block.Epoch).Debug("updating epoch summary")
+	if err := db.Epochs.Update(data.Block.Epoch, data.StakingLedgerHash); err != nil {
+		return err
+	}
+
+	// Previous epoch summary must account for all of its slots once the next epoch starts
+	if prev := data.Block.Epoch - 1; prev >= 0 {
+		epoch, err := db.Epochs.FindByEpoch(prev)
+		if err != nil && err != store.ErrNotFound {
+			return err
+		}
+		if epoch != nil && epoch.ID > 0 && epoch.SlotsAvailable < model.SlotsPerEpoch {
+			if err := db.Epochs.Update(prev, ""); err != nil {
+				return err
+			}
+		}
+	}
+
 	ts := data.Block.Time
 	buckets := []string{store.BucketHour, store.BucketDay}
 
@@ -29,6 +48,13 @@ func Finalize(db *store.Store, data *Data) error {
 			return err
 		}
 
+		log.WithField("bucket", bucket).Debug("creating snarker stats")
+		for _, snarker := range data.Snarkers {
+			if err := db.Stats.CreateSnarkerStats(snarker.Account, bucket, ts); err != nil {
+				return err
+			}
+		}
+
 		validators, err := db.Stats.FindValidatorsForDefaultStats(bucket, ts)
 		if err != nil && err != store.ErrNotFound {
 			return err
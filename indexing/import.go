@@ -34,6 +34,11 @@ func Import(db *store.Store, data *Data) error {
 		return err
 	}
 
+	log.WithField("count", len(data.AccountBalances)).Debug("creating account balances")
+	if err := db.Accounts.ImportBalances(data.AccountBalances); err != nil {
+		return err
+	}
+
 	log.WithField("count", 1).Debug("creating validators")
 	if err := db.Validators.Import([]model.Validator{*data.Validator}); err != nil {
 		return err
@@ -44,6 +49,11 @@ func Import(db *store.Store, data *Data) error {
 		return err
 	}
 
+	log.WithField("count", len(data.Tokens)).Debug("creating tokens")
+	if err := db.Tokens.Import(data.Tokens); err != nil {
+		return err
+	}
+
 	log.WithField("count", len(data.Snarkers)).Debug("creating snarkers")
 	if err := db.Snarkers.Import(data.Snarkers); err != nil {
 		return err
@@ -14,8 +14,13 @@ func Prepare(archiveBlock *archive.Block, graphBlock *graph.Block) (*Data, error
 		return nil, err
 	}
 
+	var stakingLedgerHash string
 	if graphBlock != nil {
 		block.TotalCurrency = types.NewAmount(graphBlock.ProtocolState.ConsensusState.TotalCurrency)
+
+		if data := graphBlock.ProtocolState.ConsensusState.StakingEpochData; data != nil && data.Ledger != nil {
+			stakingLedgerHash = data.Ledger.Hash
+		}
 	}
 
 	// Prepare validator record
@@ -31,6 +36,9 @@ func Prepare(archiveBlock *archive.Block, graphBlock *graph.Block) (*Data, error
 	}
 	block.TransactionsCount = len(transactions)
 
+	// Prepare accounts created by transactions
+	block.NewAccountsCount = len(mapper.AccountCreations(transactions))
+
 	// Prepare snarkers
 	snarkers, err := mapper.Snarkers(graphBlock)
 	if err != nil {
@@ -61,12 +69,15 @@ func Prepare(archiveBlock *archive.Block, graphBlock *graph.Block) (*Data, error
 	}
 
 	data := &Data{
-		Block:        block,
-		Validator:    validator,
-		Accounts:     accounts,
-		Transactions: transactions,
-		Snarkers:     snarkers,
-		SnarkJobs:    snarkJobs,
+		Block:             block,
+		StakingLedgerHash: stakingLedgerHash,
+		Validator:         validator,
+		Accounts:          accounts,
+		AccountBalances:   mapper.AccountBalances(accounts),
+		Transactions:      transactions,
+		Tokens:            mapper.Tokens(transactions),
+		Snarkers:          snarkers,
+		SnarkJobs:         snarkJobs,
 	}
 
 	return data, nil
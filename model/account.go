@@ -11,10 +11,12 @@ import (
 type Account struct {
 	ID             string       `json:"-"`
 	PublicKey      string       `json:"public_key"`
+	Token          uint64       `json:"token"`
 	Delegate       *string      `json:"delegate"`
 	Balance        types.Amount `json:"balance"`
 	BalanceUnknown types.Amount `json:"balance_unknown"`
 	Stake          types.Amount `json:"stake"`
+	CreationFee    types.Amount `json:"creation_fee"`
 	Nonce          uint64       `json:"nonce"`
 	StartHeight    uint64       `json:"start_height"`
 	StartTime      time.Time    `json:"start_time"`
@@ -34,5 +36,8 @@ func (acc Account) Validate() error {
 	if acc.PublicKey == "" {
 		return errors.New("public key is required")
 	}
+	if acc.Token == 0 {
+		return errors.New("token is required")
+	}
 	return nil
 }
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// AccountBalance contains the account balance snapshot at a given height
+type AccountBalance struct {
+	ID             int          `json:"-"`
+	PublicKey      string       `json:"public_key"`
+	Height         uint64       `json:"height"`
+	Time           time.Time    `json:"time"`
+	Balance        types.Amount `json:"balance"`
+	BalanceUnknown types.Amount `json:"balance_unknown"`
+	Nonce          uint64       `json:"nonce"`
+	CreationFee    types.Amount `json:"creation_fee"`
+	CreatedAt      time.Time    `json:"-"`
+}
+
+// TableName returns the model table name
+func (AccountBalance) TableName() string {
+	return "account_balances"
+}
+
+// Validate returns an error if balance snapshot is invalid
+func (b AccountBalance) Validate() error {
+	if b.PublicKey == "" {
+		return errors.New("public key is required")
+	}
+	if b.Time.IsZero() {
+		return errors.New("time is invalid")
+	}
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/figment-networks/mina-indexer/model/types"
 )
 
+// SlotsPerEpoch is the number of slots in a single epoch
+const SlotsPerEpoch = 7140
+
 // Block model contains block data
 type Block struct {
 	ID                int            `json:"-"`
@@ -26,6 +29,7 @@ type Block struct {
 	Slot              int            `json:"slot"`
 	TransactionsCount int            `json:"transactions_count"`
 	TransactionsFees  int            `json:"transactions_fees"`
+	NewAccountsCount  int            `json:"new_accounts_count"`
 	SnarkersCount     int            `json:"snarkers_count"`
 	SnarkerAccounts   pq.StringArray `json:"snarker_accounts"`
 	SnarkJobsCount    int            `json:"snark_jobs_count"`
@@ -39,6 +43,17 @@ type BlockIntervalStat struct {
 	Avg          float64 `json:"avg"`
 }
 
+// OrphanBlock contains a non-canonical block and the block that won its height
+type OrphanBlock struct {
+	Height        uint64    `json:"height"`
+	Hash          string    `json:"hash"`
+	Creator       string    `json:"creator"`
+	Time          time.Time `json:"time"`
+	WinnerHash    string    `json:"winner_hash"`
+	WinnerCreator string    `json:"winner_creator"`
+	WinnerTime    time.Time `json:"winner_time"`
+}
+
 // BlockAvgStat contains block averagess
 type BlockAvgStat struct {
 	StartHeight int64   `json:"start_height"`
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// ChainStat contains the chain activity stats for a time bucket
+type ChainStat struct {
+	Time                time.Time    `json:"time"`
+	BlockTimeAvg        float64      `json:"block_time_avg"`
+	BlocksCount         int          `json:"blocks_count"`
+	ValidatorsCount     int          `json:"validators_count"`
+	SnarkersCount       int          `json:"snarkers_count"`
+	JobsCount           int          `json:"jobs_count"`
+	JobsAmount          types.Amount `json:"jobs_amount"`
+	TransactionsCount   int          `json:"transactions_count"`
+	TransactionsAmount  types.Amount `json:"transactions_amount"`
+	PaymentsCount       int          `json:"payments_count"`
+	PaymentsAmount      types.Amount `json:"payments_amount"`
+	FeeTransfersCount   int          `json:"fee_transfers_count"`
+	FeeTransfersAmount  types.Amount `json:"fee_transfers_amount"`
+	CoinbaseCount       int          `json:"coinbase_count"`
+	CoinbaseAmount      types.Amount `json:"coinbase_amount"`
+	TotalCurrency       types.Amount `json:"total_currency"`
+	StakedAmount        types.Amount `json:"staked_amount"`
+	DelegationsCount    int          `json:"delegations_count"`
+	DelegationsAmount   types.Amount `json:"delegations_amount"`
+	LockedSupply        types.Amount `json:"locked_supply"`
+	CirculatingSupply   types.Amount `json:"circulating_supply"`
+	FeesCount           int          `json:"fees_count"`
+	FeeMin              types.Amount `json:"fee_min"`
+	FeeP25              types.Amount `json:"fee_p25"`
+	FeeMedian           types.Amount `json:"fee_median"`
+	FeeP75              types.Amount `json:"fee_p75"`
+	FeeMax              types.Amount `json:"fee_max"`
+	NewAccountsCount    int          `json:"new_accounts_count"`
+	AccountCreationFees types.Amount `json:"account_creation_fees"`
+}
@@ -9,3 +9,21 @@ type Delegation struct {
 	Delegate  string       `json:"delegate"`
 	Balance   types.Amount `json:"balance"`
 }
+
+// DelegationChange contains an account delegate change in an epoch
+type DelegationChange struct {
+	Epoch            int          `json:"epoch"`
+	Delegate         string       `json:"delegate"`
+	PreviousDelegate *string      `json:"previous_delegate"`
+	Balance          types.Amount `json:"balance"`
+}
+
+// DelegatorChange contains a delegator gained or lost by a validator in an epoch
+type DelegatorChange struct {
+	Epoch            int          `json:"epoch"`
+	Change           string       `json:"change"`
+	PublicKey        string       `json:"public_key"`
+	Balance          types.Amount `json:"balance"`
+	PreviousDelegate *string      `json:"previous_delegate"`
+	Delegate         *string      `json:"delegate"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// Epoch contains the block production summary for a single epoch
+type Epoch struct {
+	ID                int          `json:"-"`
+	Epoch             int          `json:"epoch"`
+	StartSlot         int          `json:"start_slot"`
+	EndSlot           int          `json:"end_slot"`
+	StartHeight       uint64       `json:"start_height"`
+	EndHeight         uint64       `json:"end_height"`
+	StartTime         time.Time    `json:"start_time"`
+	EndTime           time.Time    `json:"end_time"`
+	BlocksCount       int          `json:"blocks_count"`
+	SlotsFilled       int          `json:"slots_filled"`
+	SlotsAvailable    int          `json:"slots_available"`
+	SlotFillRate      float64      `json:"slot_fill_rate"`
+	ProducersCount    int          `json:"producers_count"`
+	Coinbase          types.Amount `json:"coinbase"`
+	Fees              types.Amount `json:"fees"`
+	SnarkFees         types.Amount `json:"snark_fees"`
+	StakingLedgerHash *string      `json:"staking_ledger_hash"`
+	CreatedAt         time.Time    `json:"-"`
+	UpdatedAt         time.Time    `json:"-"`
+}
+
+// EpochValidator contains the block production of a validator in an epoch
+type EpochValidator struct {
+	PublicKey   string       `json:"public_key"`
+	BlocksCount int          `json:"blocks_count"`
+	SlotsCount  int          `json:"slots_count"`
+	FirstHeight uint64       `json:"first_height"`
+	LastHeight  uint64       `json:"last_height"`
+	Coinbase    types.Amount `json:"coinbase"`
+	SnarkFees   types.Amount `json:"snark_fees"`
+	BlocksShare float64      `json:"blocks_share"`
+}
+
+// TableName returns the model table name
+func (Epoch) TableName() string {
+	return "epochs"
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"math"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// MinimumTransactionFee is the minimum fee accepted by the network, in nanomina
+const MinimumTransactionFee = 1000000
+
+// FeeEstimate contains suggested transaction fees
+type FeeEstimate struct {
+	Slow         types.Amount `json:"slow"`
+	Normal       types.Amount `json:"normal"`
+	Fast         types.Amount `json:"fast"`
+	RecentCount  int          `json:"recent_count"`
+	PendingCount int          `json:"pending_count"`
+}
+
+// EstimateFees suggests fees from the fees of recently included and pending transactions.
+// Slow and normal fees follow the recent inclusion fees, while the fast fee must also
+// outbid most of the transactions currently waiting in the pool.
+func EstimateFees(recent []types.Amount, pending []types.Amount) FeeEstimate {
+	minFee := types.NewInt64Amount(MinimumTransactionFee)
+
+	slow := maxAmount(minFee, FeePercentile(recent, 25))
+	normal := maxAmount(slow, FeePercentile(recent, 50))
+	fast := maxAmount(normal, FeePercentile(recent, 75), FeePercentile(pending, 75))
+
+	return FeeEstimate{
+		Slow:         slow,
+		Normal:       normal,
+		Fast:         fast,
+		RecentCount:  len(recent),
+		PendingCount: len(pending),
+	}
+}
+
+// FeePercentile returns the nearest-rank percentile of the given fees
+func FeePercentile(fees []types.Amount, percentile float64) types.Amount {
+	sorted := sortedAmounts(fees)
+	if len(sorted) == 0 {
+		return types.NewInt64Amount(0)
+	}
+
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+
+	return sorted[rank-1]
+}
+
+func maxAmount(amount types.Amount, others ...types.Amount) types.Amount {
+	for _, other := range others {
+		if other.Compare(amount) > 0 {
+			amount = other
+		}
+	}
+	return amount
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func testAmounts(vals ...int64) []types.Amount {
+	result := make([]types.Amount, len(vals))
+	for i, v := range vals {
+		result[i] = types.NewInt64Amount(v)
+	}
+	return result
+}
+
+func TestFeePercentile(t *testing.T) {
+	fees := testAmounts(50, 10, 40, 20, 30)
+
+	assert.Equal(t, "0", FeePercentile(nil, 50).String())
+	assert.Equal(t, "10", FeePercentile(fees, 0).String())
+	assert.Equal(t, "20", FeePercentile(fees, 25).String())
+	assert.Equal(t, "30", FeePercentile(fees, 50).String())
+	assert.Equal(t, "40", FeePercentile(fees, 75).String())
+	assert.Equal(t, "50", FeePercentile(fees, 100).String())
+}
+
+func TestEstimateFees(t *testing.T) {
+	t.Run("no data", func(t *testing.T) {
+		est := EstimateFees(nil, nil)
+		assert.Equal(t, "1000000", est.Slow.String())
+		assert.Equal(t, "1000000", est.Normal.String())
+		assert.Equal(t, "1000000", est.Fast.String())
+	})
+
+	t.Run("recent fees only", func(t *testing.T) {
+		est := EstimateFees(testAmounts(2000000, 4000000, 6000000, 8000000), nil)
+		assert.Equal(t, "2000000", est.Slow.String())
+		assert.Equal(t, "4000000", est.Normal.String())
+		assert.Equal(t, "6000000", est.Fast.String())
+		assert.Equal(t, 4, est.RecentCount)
+		assert.Equal(t, 0, est.PendingCount)
+	})
+
+	t.Run("congested pool", func(t *testing.T) {
+		est := EstimateFees(testAmounts(2000000, 4000000), testAmounts(10000000, 20000000))
+		assert.Equal(t, "2000000", est.Slow.String())
+		assert.Equal(t, "2000000", est.Normal.String())
+		assert.Equal(t, "20000000", est.Fast.String())
+		assert.Equal(t, 2, est.PendingCount)
+	})
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// MaxForkLength is the maximum number of blocks followed on a fork branch
+const MaxForkLength = 290
+
+// Fork contains all competing blocks indexed at the same height
+type Fork struct {
+	Height   uint64       `json:"height"`
+	Branches []ForkBranch `json:"branches"`
+}
+
+// ForkBranch contains a competing block and the length of the longest chain built on top of it
+type ForkBranch struct {
+	Height     uint64    `json:"-"`
+	Hash       string    `json:"hash"`
+	ParentHash string    `json:"parent_hash"`
+	Creator    string    `json:"creator"`
+	Time       time.Time `json:"time"`
+	Canonical  bool      `json:"canonical"`
+	Length     int       `json:"length"`
+}
+
+// GroupForks groups branches ordered by height into forks
+func GroupForks(branches []ForkBranch) []Fork {
+	result := []Fork{}
+
+	for _, branch := range branches {
+		if n := len(result); n == 0 || result[n-1].Height != branch.Height {
+			result = append(result, Fork{Height: branch.Height})
+		}
+		fork := &result[len(result)-1]
+		fork.Branches = append(fork.Branches, branch)
+	}
+
+	return result
+}
@@ -1,25 +1,70 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/figment-networks/mina-indexer/model/types"
 )
 
+const (
+	// LedgerTypeCurrent is the staking ledger of the epoch
+	LedgerTypeCurrent = "current"
+
+	// LedgerTypeNext is the staking ledger the epoch will use once it starts
+	LedgerTypeNext = "next"
+)
+
 type Ledger struct {
 	ID                int          `json:"-"`
+	Type              string       `json:"type"`
+	Hash              *string      `json:"hash"`
 	Time              time.Time    `json:"time"`
 	Epoch             int          `json:"epoch"`
 	EntriesCount      int          `json:"entries_count"`
 	StakedAmount      types.Amount `json:"staked_amount"`
 	DelegationsCount  int          `json:"delegations_count"`
 	DelegationsAmount types.Amount `json:"delegations_amount"`
+	TotalCurrency     types.Amount `json:"total_currency"`
+	Verified          bool         `json:"verified"`
+	VerifyError       *string      `json:"verify_error"`
+	VerifiedAt        *time.Time   `json:"verified_at"`
 }
 
 func (Ledger) TableName() string {
 	return "ledgers"
 }
 
+// Verify returns an error if the stored entries totals don't match the ledger
+func (l Ledger) Verify(entriesCount int, balance types.Amount) error {
+	if entriesCount != l.EntriesCount {
+		return fmt.Errorf("ledger entries count mismatch: expected %d, got %d", l.EntriesCount, entriesCount)
+	}
+
+	if l.StakedAmount.Int == nil || balance.Int == nil || balance.Compare(l.StakedAmount) != 0 {
+		return fmt.Errorf("ledger balance mismatch: expected %s, got %s", l.StakedAmount, balance)
+	}
+
+	// Total currency is only available for ledgers imported along with the chain state
+	if l.TotalCurrency.Int != nil && l.TotalCurrency.Compare(l.StakedAmount) != 0 {
+		return fmt.Errorf("ledger total currency mismatch: expected %s, got %s", l.TotalCurrency, l.StakedAmount)
+	}
+
+	return nil
+}
+
+// SetVerification records the result of the ledger verification
+func (l *Ledger) SetVerification(err error, now time.Time) {
+	l.Verified = err == nil
+	l.VerifyError = nil
+	l.VerifiedAt = &now
+
+	if err != nil {
+		msg := err.Error()
+		l.VerifyError = &msg
+	}
+}
+
 type LedgerEntry struct {
 	ID                          int          `json:"-"`
 	LedgerID                    int          `json:"-"`
@@ -31,9 +76,48 @@ type LedgerEntry struct {
 	TimingCliffTime             *int         `json:"timing_cliff_time"`
 	TimingCliffAmount           types.Amount `json:"timing_cliff_amount"`
 	TimingVestingPeriod         *int         `json:"timing_vesting_period"`
-	TimingVestingIncrement      *int         `json:"timing_vesting_increment"`
+	TimingVestingIncrement      types.Amount `json:"timing_vesting_increment"`
 }
 
 func (LedgerEntry) TableName() string {
 	return "ledger_entries"
 }
+
+// LedgerDiff contains the changes between two staking ledgers
+type LedgerDiff struct {
+	From       int                    `json:"from"`
+	To         int                    `json:"to"`
+	Summary    LedgerDiffSummary      `json:"summary"`
+	Accounts   []LedgerAccountChange  `json:"accounts"`
+	Validators []ValidatorStakeChange `json:"validators"`
+}
+
+// LedgerDiffSummary contains the change totals between two staking ledgers
+type LedgerDiffSummary struct {
+	AddedCount           int          `json:"added_count"`
+	RemovedCount         int          `json:"removed_count"`
+	BalanceChangesCount  int          `json:"balance_changes_count"`
+	DelegateChangesCount int          `json:"delegate_changes_count"`
+	BalanceChange        types.Amount `json:"balance_change"`
+}
+
+// LedgerAccountChange contains an account change between two staking ledgers
+type LedgerAccountChange struct {
+	PublicKey        string       `json:"public_key"`
+	Change           string       `json:"change"`
+	PreviousBalance  types.Amount `json:"previous_balance"`
+	Balance          types.Amount `json:"balance"`
+	BalanceChange    types.Amount `json:"balance_change"`
+	PreviousDelegate *string      `json:"previous_delegate"`
+	Delegate         *string      `json:"delegate"`
+}
+
+// ValidatorStakeChange contains a validator stake change between two staking ledgers
+type ValidatorStakeChange struct {
+	PublicKey           string       `json:"public_key"`
+	PreviousStake       types.Amount `json:"previous_stake"`
+	Stake               types.Amount `json:"stake"`
+	StakeChange         types.Amount `json:"stake_change"`
+	PreviousDelegations int          `json:"previous_delegations"`
+	Delegations         int          `json:"delegations"`
+}
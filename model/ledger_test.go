@@ -0,0 +1,43 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func TestLedgerVerify(t *testing.T) {
+	ledger := Ledger{
+		EntriesCount: 2,
+		StakedAmount: types.NewInt64Amount(1000),
+	}
+
+	assert.NoError(t, ledger.Verify(2, types.NewInt64Amount(1000)))
+	assert.Error(t, ledger.Verify(1, types.NewInt64Amount(1000)))
+	assert.Error(t, ledger.Verify(2, types.NewInt64Amount(999)))
+	assert.Error(t, ledger.Verify(2, types.Amount{}))
+
+	ledger.TotalCurrency = types.NewInt64Amount(1000)
+	assert.NoError(t, ledger.Verify(2, types.NewInt64Amount(1000)))
+
+	ledger.TotalCurrency = types.NewInt64Amount(1001)
+	assert.Error(t, ledger.Verify(2, types.NewInt64Amount(1000)))
+}
+
+func TestLedgerSetVerification(t *testing.T) {
+	now := time.Now()
+	ledger := Ledger{}
+
+	ledger.SetVerification(errors.New("ledger balance mismatch"), now)
+	assert.False(t, ledger.Verified)
+	assert.Equal(t, "ledger balance mismatch", *ledger.VerifyError)
+	assert.Equal(t, now, *ledger.VerifiedAt)
+
+	ledger.SetVerification(nil, now)
+	assert.True(t, ledger.Verified)
+	assert.Nil(t, ledger.VerifyError)
+}
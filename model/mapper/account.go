@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"fmt"
+
 	"github.com/figment-networks/mina-indexer/client/archive"
 	"github.com/figment-networks/mina-indexer/client/graph"
 	"github.com/figment-networks/mina-indexer/model"
@@ -15,6 +17,7 @@ func Account(block *graph.Block, input *graph.Account) (*model.Account, error) {
 
 	acc := &model.Account{
 		PublicKey:      input.PublicKey,
+		Token:          model.DefaultToken,
 		StartHeight:    height,
 		StartTime:      time,
 		LastHeight:     height,
@@ -73,10 +76,16 @@ func AccountFromStagedLedger(block *graph.Block, entry *archive.StakingInfo) (*m
 	height := BlockHeight(block)
 	time := BlockTime(block)
 
+	balance, err := types.ParseMINA(entry.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance of account %s: %w", entry.Pk, err)
+	}
+
 	account := &model.Account{
 		PublicKey:      entry.Pk,
-		Balance:        types.NewFloatAmount(entry.Balance),
-		BalanceUnknown: types.NewFloatAmount(entry.Balance),
+		Token:          ParseTokenID(entry.Token),
+		Balance:        balance,
+		BalanceUnknown: balance,
 		StartHeight:    height,
 		StartTime:      time,
 		LastHeight:     height,
@@ -92,3 +101,25 @@ func AccountFromStagedLedger(block *graph.Block, entry *archive.StakingInfo) (*m
 
 	return account, nil
 }
+
+// AccountBalances returns MINA balance snapshots for the given accounts at their last height
+func AccountBalances(accounts []model.Account) []model.AccountBalance {
+	result := make([]model.AccountBalance, 0, len(accounts))
+
+	for _, acc := range accounts {
+		if acc.Token != model.DefaultToken {
+			continue
+		}
+
+		result = append(result, model.AccountBalance{
+			PublicKey:      acc.PublicKey,
+			Height:         acc.LastHeight,
+			Time:           acc.LastTime,
+			Balance:        acc.Balance,
+			BalanceUnknown: acc.BalanceUnknown,
+			Nonce:          acc.Nonce,
+		})
+	}
+
+	return result
+}
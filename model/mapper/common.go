@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/figment-networks/mina-indexer/client/graph"
+	"github.com/figment-networks/mina-indexer/model"
 	"github.com/figment-networks/mina-indexer/model/util"
 )
 
@@ -24,6 +25,20 @@ func BlockTime(input *graph.Block) time.Time {
 	return util.MustTime(input.ProtocolState.BlockchainState.Date)
 }
 
+// TokenID returns a token ID, defaulting to the MINA token when not set
+func TokenID(id uint64) uint64 {
+	if id == 0 {
+		return model.DefaultToken
+	}
+	return id
+}
+
+// ParseTokenID returns a parsed token ID, defaulting to the MINA token when not set
+func ParseTokenID(input string) uint64 {
+	id, _ := util.ParseUInt64(input)
+	return TokenID(id)
+}
+
 func blockCheck(input *graph.Block) error {
 	if input.ProtocolState == nil {
 		return errNoProtocolState
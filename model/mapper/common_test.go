@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model"
+)
+
+func TestParseTokenID(t *testing.T) {
+	examples := map[string]uint64{
+		"":        model.DefaultToken,
+		"0":       model.DefaultToken,
+		"1":       model.DefaultToken,
+		"2":       2,
+		"1000":    1000,
+		"invalid": model.DefaultToken,
+	}
+
+	for input, expected := range examples {
+		assert.Equal(t, expected, ParseTokenID(input), input)
+	}
+}
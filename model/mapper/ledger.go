@@ -22,19 +22,72 @@ func (data *LedgerData) UpdateLedgerID() {
 	}
 }
 
-func Ledger(tip *graph.Block, records []archive.StakingInfo) (*LedgerData, error) {
+func Ledger(tip *graph.Block, ledgerType string, records []archive.StakingInfo) (*LedgerData, error) {
+	consensus := tip.ProtocolState.ConsensusState
+
+	var epoch int
+	fmt.Sscanf(consensus.Epoch, "%d", &epoch)
+
+	data, err := EpochLedger(epoch, ledgerType, records)
+	if err != nil {
+		return nil, err
+	}
+	ledgerRecord := data.Ledger
+
+	var epochLedger *graph.EpochLedger
+	if consensus.StakingEpochData != nil {
+		epochLedger = consensus.StakingEpochData.Ledger
+	}
+
+	// Next ledger becomes the staking ledger of the following epoch
+	if ledgerType == model.LedgerTypeNext {
+		ledgerRecord.Epoch++
+
+		epochLedger = nil
+		if consensus.NextEpochData != nil {
+			epochLedger = consensus.NextEpochData.Ledger
+		}
+	}
+
+	if epochLedger != nil {
+		if epochLedger.Hash != "" {
+			ledgerRecord.Hash = &epochLedger.Hash
+		}
+		if epochLedger.TotalCurrency != "" {
+			ledgerRecord.TotalCurrency = types.NewAmount(epochLedger.TotalCurrency)
+		}
+	}
+
+	return data, nil
+}
+
+// EpochLedger returns the ledger data of an epoch from the staking ledger records
+func EpochLedger(epoch int, ledgerType string, records []archive.StakingInfo) (*LedgerData, error) {
 	ledgerRecord := &model.Ledger{
-		EntriesCount:      len(records),
+		Type:              ledgerType,
+		Epoch:             epoch,
 		Time:              time.Now(),
 		DelegationsAmount: types.NewInt64Amount(0),
 		StakedAmount:      types.NewInt64Amount(0),
 	}
-	fmt.Sscanf(tip.ProtocolState.ConsensusState.Epoch, "%d", &ledgerRecord.Epoch)
 
 	entries := []model.LedgerEntry{}
 
 	for _, record := range records {
-		balance := types.NewFloatAmount(record.Balance)
+		// Only MINA accounts take part in staking
+		if ParseTokenID(record.Token) != model.DefaultToken {
+			continue
+		}
+
+		// Exported ledgers omit the delegate of accounts that were never delegated
+		if record.Delegate == "" {
+			record.Delegate = record.Pk
+		}
+
+		balance, err := types.ParseMINA(record.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance of account %s: %w", record.Pk, err)
+		}
 
 		entry := model.LedgerEntry{
 			LedgerID:                    ledgerRecord.ID,
@@ -44,6 +97,7 @@ func Ledger(tip *graph.Block, records []archive.StakingInfo) (*LedgerData, error
 			Delegation:                  record.Pk != record.Delegate,
 			TimingInitialMinimumBalance: types.Amount{},
 			TimingCliffAmount:           types.Amount{},
+			TimingVestingIncrement:      types.Amount{},
 		}
 
 		ledgerRecord.StakedAmount = ledgerRecord.StakedAmount.Add(balance)
@@ -55,19 +109,33 @@ func Ledger(tip *graph.Block, records []archive.StakingInfo) (*LedgerData, error
 
 		if timing := record.Timing; timing != nil {
 			cliffTime, _ := util.ParseInt(timing.CliffTime)
-			vestingIncrement, _ := util.ParseInt(timing.VestingIncrement)
 			vestingPeriod, _ := util.ParseInt(timing.VestingPeriod)
 
-			entry.TimingInitialMinimumBalance = types.NewFloatAmount(timing.InitialMinimumBalance)
-			entry.TimingCliffAmount = types.NewFloatAmount(timing.CliffAmount)
+			initialMinimumBalance, err := types.ParseMINA(timing.InitialMinimumBalance)
+			if err != nil {
+				return nil, fmt.Errorf("invalid initial minimum balance of account %s: %w", record.Pk, err)
+			}
+			cliffAmount, err := types.ParseMINA(timing.CliffAmount)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cliff amount of account %s: %w", record.Pk, err)
+			}
+			vestingIncrement, err := types.ParseMINA(timing.VestingIncrement)
+			if err != nil {
+				return nil, fmt.Errorf("invalid vesting increment of account %s: %w", record.Pk, err)
+			}
+
+			entry.TimingInitialMinimumBalance = initialMinimumBalance
+			entry.TimingCliffAmount = cliffAmount
 			entry.TimingCliffTime = &cliffTime
-			entry.TimingVestingIncrement = &vestingIncrement
+			entry.TimingVestingIncrement = vestingIncrement
 			entry.TimingVestingPeriod = &vestingPeriod
 		}
 
 		entries = append(entries, entry)
 	}
 
+	ledgerRecord.EntriesCount = len(entries)
+
 	return &LedgerData{
 		Ledger:  ledgerRecord,
 		Entries: entries,
@@ -0,0 +1,142 @@
+package mapper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/client/archive"
+	"github.com/figment-networks/mina-indexer/client/graph"
+	"github.com/figment-networks/mina-indexer/model"
+)
+
+// minaString formats the nanomina value as a decimal MINA string, as exported by the node
+func minaString(nanomina uint64) string {
+	return fmt.Sprintf("%d.%09d", nanomina/1000000000, nanomina%1000000000)
+}
+
+func testStakingRecords(t *testing.T, balances []uint64) []archive.StakingInfo {
+	input := []map[string]interface{}{}
+	for idx, balance := range balances {
+		input = append(input, map[string]interface{}{
+			"pk":       fmt.Sprintf("B62q%d", idx),
+			"delegate": "B62qdelegate",
+			"balance":  minaString(balance),
+			"timing": map[string]string{
+				"initial_minimum_balance": minaString(balance),
+				"cliff_time":              "100",
+				"cliff_amount":            minaString(balance / 2),
+				"vesting_period":          "1",
+				"vesting_increment":       minaString(balance / 3),
+			},
+		})
+	}
+
+	data, err := json.Marshal(input)
+	assert.NoError(t, err)
+
+	records := []archive.StakingInfo{}
+	assert.NoError(t, json.Unmarshal(data, &records))
+
+	return records
+}
+
+func TestLedgerAmounts(t *testing.T) {
+	tip := &graph.Block{
+		ProtocolState: &graph.ProtocolState{
+			ConsensusState: &graph.ConsensusState{Epoch: "5"},
+		},
+	}
+
+	check := func(balances []uint64) bool {
+		data, err := Ledger(tip, model.LedgerTypeCurrent, testStakingRecords(t, balances))
+		if err != nil {
+			return false
+		}
+
+		total := new(big.Int)
+		for idx, balance := range balances {
+			entry := data.Entries[idx]
+			expected := new(big.Int).SetUint64(balance)
+
+			if entry.Balance.Cmp(expected) != 0 ||
+				entry.TimingInitialMinimumBalance.Cmp(expected) != 0 ||
+				entry.TimingCliffAmount.Cmp(new(big.Int).SetUint64(balance/2)) != 0 ||
+				entry.TimingVestingIncrement.Cmp(new(big.Int).SetUint64(balance/3)) != 0 {
+				return false
+			}
+			total.Add(total, expected)
+		}
+
+		return data.Ledger.StakedAmount.Cmp(total) == 0 &&
+			data.Ledger.Verify(len(balances), data.Ledger.StakedAmount) == nil
+	}
+
+	assert.NoError(t, quick.Check(check, nil))
+}
+
+func TestEpochLedgerTokens(t *testing.T) {
+	records := testStakingRecords(t, []uint64{1000, 2000, 3000})
+	records[1].Token = "2"
+	records[2].Token = "1"
+
+	data, err := EpochLedger(5, model.LedgerTypeCurrent, records)
+	assert.NoError(t, err)
+
+	assert.Len(t, data.Entries, 2)
+	assert.Equal(t, 2, data.Ledger.EntriesCount)
+	assert.Equal(t, "B62q0", data.Entries[0].PublicKey)
+	assert.Equal(t, "B62q2", data.Entries[1].PublicKey)
+	assert.Equal(t, "4000", data.Ledger.StakedAmount.String())
+}
+
+func TestAccountFromStagedLedgerAmounts(t *testing.T) {
+	block := &graph.Block{
+		ProtocolState: &graph.ProtocolState{
+			ConsensusState:  &graph.ConsensusState{BlockHeight: "10"},
+			BlockchainState: &graph.BlockchainState{Date: "1615939200000"},
+		},
+	}
+
+	check := func(balance uint64) bool {
+		record := testStakingRecords(t, []uint64{balance})[0]
+
+		account, err := AccountFromStagedLedger(block, &record)
+		if err != nil {
+			return false
+		}
+
+		expected := new(big.Int).SetUint64(balance)
+		return account.Balance.Cmp(expected) == 0 && account.BalanceUnknown.Cmp(expected) == 0
+	}
+
+	assert.NoError(t, quick.Check(check, nil))
+}
+
+func TestInvalidLedgerAmounts(t *testing.T) {
+	block := &graph.Block{
+		ProtocolState: &graph.ProtocolState{
+			ConsensusState:  &graph.ConsensusState{BlockHeight: "10"},
+			BlockchainState: &graph.BlockchainState{Date: "1615939200000"},
+		},
+	}
+
+	records := testStakingRecords(t, []uint64{1000})
+	records[0].Balance = "invalid"
+
+	_, err := EpochLedger(5, model.LedgerTypeCurrent, records)
+	assert.Error(t, err)
+
+	_, err = AccountFromStagedLedger(block, &records[0])
+	assert.Error(t, err)
+
+	records = testStakingRecords(t, []uint64{1000})
+	records[0].Timing.CliffAmount = "invalid"
+
+	_, err = EpochLedger(5, model.LedgerTypeCurrent, records)
+	assert.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/client/graph"
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// SnarkPool returns a snark pool snapshot from the completed and pending snark work
+func SnarkPool(ts time.Time, completed []graph.CompletedWork, pending []graph.PendingSnarkWork) *model.SnarkPool {
+	pool := &model.SnarkPool{
+		Time:                ts,
+		CompletedWorksCount: len(completed),
+	}
+
+	for _, work := range pending {
+		pool.PendingWorksCount += len(work.WorkBundle)
+	}
+
+	provers := map[string]bool{}
+	fees := make([]types.Amount, len(completed))
+
+	for idx, work := range completed {
+		provers[work.Prover] = true
+		fees[idx] = types.NewAmount(work.Fee)
+	}
+
+	pool.ProversCount = len(provers)
+	pool.FeeMin, pool.FeeMedian, pool.FeeMax = model.FeeDistribution(fees)
+
+	return pool
+}
@@ -0,0 +1,26 @@
+package mapper
+
+import (
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// Supply returns a supply model for the block using the timed ledger entries of its epoch
+func Supply(block *model.Block, entries []model.LedgerEntry) *model.Supply {
+	locked := model.LockedSupplyAt(entries, block.Slot)
+	circulating := block.TotalCurrency.Sub(locked)
+	if circulating.Sign() < 0 {
+		circulating = types.NewInt64Amount(0)
+	}
+
+	return &model.Supply{
+		Height:            block.Height,
+		BlockHash:         block.Hash,
+		Time:              block.Time,
+		Epoch:             block.Epoch,
+		Slot:              block.Slot,
+		TotalSupply:       block.TotalCurrency,
+		LockedSupply:      locked,
+		CirculatingSupply: circulating,
+	}
+}
@@ -15,10 +15,13 @@ func UserTransaction(block *graph.Block, t *graph.UserCommand) (*model.Transacti
 		ttype = model.TxTypeDelegation
 	}
 
-	var memoText *string
+	var memoText, memoRaw *string
 	if text := util.ParseMemoText(t.Memo); len(text) > 0 {
 		memoText = &text
 	}
+	if t.Memo != "" {
+		memoRaw = &t.Memo
+	}
 
 	tran := &model.Transaction{
 		Type:        ttype,
@@ -30,8 +33,11 @@ func UserTransaction(block *graph.Block, t *graph.UserCommand) (*model.Transacti
 		Receiver:    t.To,
 		Amount:      types.NewAmount(t.Amount),
 		Fee:         types.NewAmount(t.Fee),
+		Token:       model.DefaultToken,
+		FeeToken:    model.DefaultToken,
 		Nonce:       &t.Nonce,
 		Memo:        memoText,
+		MemoRaw:     memoRaw,
 	}
 
 	return tran, tran.Validate()
@@ -47,6 +53,8 @@ func BlockRewardTransaction(block *graph.Block) (*model.Transaction, error) {
 		Receiver:    block.Transactions.CoinbaseReceiver.PublicKey,
 		Amount:      types.NewAmount(block.Transactions.Coinbase),
 		Fee:         types.NewInt64Amount(0),
+		Token:       model.DefaultToken,
+		FeeToken:    model.DefaultToken,
 	}
 
 	return t, t.Validate()
@@ -64,6 +72,8 @@ func FeeTransaction(block *graph.Block, transfer *graph.FeeTransfer) (*model.Tra
 		Receiver:    transfer.Recipient,
 		Amount:      types.NewAmount(transfer.Fee),
 		Fee:         types.NewInt64Amount(0),
+		Token:       model.DefaultToken,
+		FeeToken:    model.DefaultToken,
 	}
 
 	return t, t.Validate()
@@ -82,6 +92,8 @@ func SnarkFeeTransaction(block *graph.Block, transfer *graph.FeeTransfer) (*mode
 		Receiver:    transfer.Recipient,
 		Amount:      types.NewAmount(transfer.Fee),
 		Fee:         types.NewInt64Amount(0),
+		Token:       model.DefaultToken,
+		FeeToken:    model.DefaultToken,
 	}
 
 	return t, t.Validate()
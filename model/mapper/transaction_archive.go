@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/figment-networks/mina-indexer/client/archive"
@@ -24,6 +25,8 @@ func TransactionsFromArchive(block *archive.Block) ([]model.Transaction, error)
 			Time:                    blockTime,
 			Receiver:                cmd.Receiver,
 			Amount:                  types.NewInt64Amount(cmd.Fee),
+			Token:                   TokenID(uint64(cmd.Token)),
+			FeeToken:                TokenID(uint64(cmd.Token)),
 			Status:                  model.TxStatusApplied,
 			SequenceNumber:          &cmd.SequenceNo,
 			SecondarySequenceNumber: &cmd.SecondarySequenceNo,
@@ -34,29 +37,103 @@ func TransactionsFromArchive(block *archive.Block) ([]model.Transaction, error)
 	for _, cmd := range block.UserCommands {
 		sender := cmd.Sender
 
-		var memoText *string
+		var memoText, memoRaw *string
 		if text := util.ParseMemoText(cmd.Memo); len(text) > 0 {
 			memoText = &text
 		}
+		if cmd.Memo != "" {
+			raw := cmd.Memo
+			memoRaw = &raw
+		}
 
 		result[idx] = model.Transaction{
-			Type:           cmd.Type,
-			Hash:           cmd.Hash,
-			BlockHash:      block.StateHash,
-			BlockHeight:    blockHeight,
-			Time:           blockTime,
-			Sender:         &sender,
-			Receiver:       cmd.Receiver,
-			Amount:         types.NewInt64Amount(cmd.Amount),
-			Fee:            types.NewInt64Amount(cmd.Fee),
-			Status:         cmd.Status,
-			FailureReason:  cmd.FailureReason,
-			SequenceNumber: &cmd.SequenceNo,
-			Nonce:          &cmd.Nonce,
-			Memo:           memoText,
+			Type:                       cmd.Type,
+			Hash:                       cmd.Hash,
+			BlockHash:                  block.StateHash,
+			BlockHeight:                blockHeight,
+			Time:                       blockTime,
+			Sender:                     &sender,
+			Receiver:                   cmd.Receiver,
+			Amount:                     types.NewInt64Amount(cmd.Amount),
+			Fee:                        types.NewInt64Amount(cmd.Fee),
+			Token:                      TokenID(uint64(cmd.Token)),
+			FeeToken:                   TokenID(uint64(cmd.FeeToken)),
+			CreatedToken:               cmd.CreatedToken,
+			FeePayerAccountCreationFee: optionalAmount(cmd.FeePayerAccountCreationFeePaid),
+			ReceiverAccountCreationFee: optionalAmount(cmd.ReceiverAccountCreationFeePaid),
+			Status:                     cmd.Status,
+			FailureReason:              cmd.FailureReason,
+			SequenceNumber:             &cmd.SequenceNo,
+			Nonce:                      &cmd.Nonce,
+			Memo:                       memoText,
+			MemoRaw:                    memoRaw,
 		}
 		idx++
 	}
 
 	return result, nil
 }
+
+// Tokens returns the tokens created by the transactions
+func Tokens(transactions []model.Transaction) []model.Token {
+	result := []model.Token{}
+
+	for _, tx := range transactions {
+		if tx.CreatedToken == nil || *tx.CreatedToken <= model.DefaultToken {
+			continue
+		}
+
+		hash := tx.Hash
+		result = append(result, model.Token{
+			ID:     *tx.CreatedToken,
+			Owner:  tx.Sender,
+			TxHash: &hash,
+			Height: tx.BlockHeight,
+			Time:   tx.Time,
+		})
+	}
+
+	return result
+}
+
+// AccountCreations returns the accounts created by the transactions, along with the fee paid
+func AccountCreations(transactions []model.Transaction) []model.Account {
+	result := []model.Account{}
+	seen := map[string]bool{}
+
+	add := func(tx model.Transaction, publicKey string, token uint64, fee types.Amount) {
+		key := fmt.Sprintf("%s:%d", publicKey, token)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		result = append(result, model.Account{
+			PublicKey:   publicKey,
+			Token:       token,
+			CreationFee: fee,
+			StartHeight: tx.BlockHeight,
+			StartTime:   tx.Time,
+			LastHeight:  tx.BlockHeight,
+			LastTime:    tx.Time,
+		})
+	}
+
+	for _, tx := range transactions {
+		if tx.FeePayerAccountCreationFee.Int != nil && tx.Sender != nil {
+			add(tx, *tx.Sender, tx.FeeToken, tx.FeePayerAccountCreationFee)
+		}
+		if tx.ReceiverAccountCreationFee.Int != nil {
+			add(tx, tx.Receiver, tx.Token, tx.ReceiverAccountCreationFee)
+		}
+	}
+
+	return result
+}
+
+func optionalAmount(val *uint64) types.Amount {
+	if val == nil {
+		return types.Amount{}
+	}
+	return types.NewUInt64Amount(*val)
+}
@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model"
+)
+
+func TestTokens(t *testing.T) {
+	owner := "B62qowner"
+	defaultToken := model.DefaultToken
+	newToken := uint64(5)
+
+	tokens := Tokens([]model.Transaction{
+		{Hash: "tx1", Sender: &owner, BlockHeight: 10},
+		{Hash: "tx2", Sender: &owner, BlockHeight: 10, CreatedToken: &defaultToken},
+		{Hash: "tx3", Sender: &owner, BlockHeight: 11, CreatedToken: &newToken},
+	})
+
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, newToken, tokens[0].ID)
+	assert.Equal(t, &owner, tokens[0].Owner)
+	assert.Equal(t, "tx3", *tokens[0].TxHash)
+	assert.Equal(t, uint64(11), tokens[0].Height)
+}
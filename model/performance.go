@@ -0,0 +1,88 @@
+package model
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+const (
+	// ActiveSlotCoefficient is the probability of a slot having at least one winner
+	ActiveSlotCoefficient = 0.75
+
+	// SlotDuration is the duration of a single slot
+	SlotDuration = 3 * time.Minute
+)
+
+// ValidatorPerformance contains expected and actual block production of a validator
+type ValidatorPerformance struct {
+	Validator      string       `json:"validator"`
+	Epoch          int          `json:"epoch"`
+	Stake          types.Amount `json:"stake"`
+	TotalStake     types.Amount `json:"total_stake"`
+	StakeShare     float64      `json:"stake_share"`
+	Slots          int          `json:"slots"`
+	ExpectedBlocks float64      `json:"expected_blocks"`
+	ProducedBlocks int          `json:"produced_blocks"`
+	OrphanedBlocks int          `json:"orphaned_blocks"`
+	MissedBlocks   float64      `json:"missed_blocks"`
+	OrphanRate     float64      `json:"orphan_rate"`
+	Score          float64      `json:"score"`
+}
+
+// Calculate fills in the estimated values from the stake and block counts
+func (p *ValidatorPerformance) Calculate() {
+	p.StakeShare = StakeShare(p.Stake, p.TotalStake)
+	p.ExpectedBlocks = ExpectedBlocks(p.StakeShare, p.Slots)
+	p.MissedBlocks = math.Max(p.ExpectedBlocks-float64(p.ProducedBlocks), 0)
+	p.OrphanRate = OrphanRate(p.ProducedBlocks, p.OrphanedBlocks)
+	p.Score = PerformanceScore(p.ProducedBlocks, p.ExpectedBlocks)
+}
+
+// StakeShare returns the fraction of the total stake
+func StakeShare(stake, total types.Amount) float64 {
+	if stake.Int == nil || total.Int == nil || total.Sign() <= 0 {
+		return 0
+	}
+	share, _ := new(big.Float).Quo(new(big.Float).SetInt(stake.Int), new(big.Float).SetInt(total.Int)).Float64()
+	return share
+}
+
+// SlotWinProbability returns the probability of winning a slot for a given stake share
+func SlotWinProbability(share float64) float64 {
+	if share <= 0 {
+		return 0
+	}
+	return 1 - math.Pow(1-ActiveSlotCoefficient, share)
+}
+
+// ExpectedBlocks returns the estimated number of blocks produced in a number of slots
+func ExpectedBlocks(share float64, slots int) float64 {
+	if slots <= 0 {
+		return 0
+	}
+	return roundFloat(SlotWinProbability(share) * float64(slots))
+}
+
+// PerformanceScore returns the ratio of produced to expected blocks
+func PerformanceScore(produced int, expected float64) float64 {
+	if expected <= 0 {
+		return 0
+	}
+	return roundFloat(float64(produced) / expected)
+}
+
+// OrphanRate returns the ratio of orphaned blocks to all created blocks
+func OrphanRate(produced, orphaned int) float64 {
+	total := produced + orphaned
+	if total == 0 {
+		return 0
+	}
+	return roundFloat(float64(orphaned) / float64(total))
+}
+
+func roundFloat(val float64) float64 {
+	return math.Round(val*10000) / 10000
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func TestStakeShare(t *testing.T) {
+	assert.Equal(t, 0.25, StakeShare(types.NewInt64Amount(250), types.NewInt64Amount(1000)))
+	assert.Equal(t, 0.0, StakeShare(types.NewInt64Amount(250), types.NewInt64Amount(0)))
+	assert.Equal(t, 0.0, StakeShare(types.Amount{}, types.NewInt64Amount(1000)))
+}
+
+func TestExpectedBlocks(t *testing.T) {
+	assert.Equal(t, 0.0, ExpectedBlocks(0, SlotsPerEpoch))
+	assert.Equal(t, 0.0, ExpectedBlocks(0.5, 0))
+	assert.Equal(t, 5355.0, ExpectedBlocks(1, SlotsPerEpoch))
+	assert.Equal(t, 3570.0, ExpectedBlocks(0.5, SlotsPerEpoch))
+	assert.InDelta(t, 98.3, ExpectedBlocks(0.01, SlotsPerEpoch), 0.01)
+}
+
+func TestPerformanceScore(t *testing.T) {
+	assert.Equal(t, 0.0, PerformanceScore(10, 0))
+	assert.Equal(t, 0.5, PerformanceScore(5, 10))
+	assert.Equal(t, 1.2, PerformanceScore(12, 10))
+}
+
+func TestOrphanRate(t *testing.T) {
+	assert.Equal(t, 0.0, OrphanRate(0, 0))
+	assert.Equal(t, 0.2, OrphanRate(8, 2))
+	assert.Equal(t, 1.0, OrphanRate(0, 3))
+}
+
+func TestValidatorPerformanceCalculate(t *testing.T) {
+	p := ValidatorPerformance{
+		Stake:          types.NewInt64Amount(500),
+		TotalStake:     types.NewInt64Amount(1000),
+		Slots:          100,
+		ProducedBlocks: 40,
+		OrphanedBlocks: 10,
+	}
+	p.Calculate()
+
+	assert.Equal(t, 0.5, p.StakeShare)
+	assert.Equal(t, 50.0, p.ExpectedBlocks)
+	assert.Equal(t, 10.0, p.MissedBlocks)
+	assert.Equal(t, 0.2, p.OrphanRate)
+	assert.Equal(t, 0.8, p.Score)
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// EpochIncome contains the block producer income for an epoch
+type EpochIncome struct {
+	BlocksProduced int          `json:"blocks_produced"`
+	Coinbase       types.Amount `json:"coinbase"`
+	Fees           types.Amount `json:"fees"`
+	SnarkFees      types.Amount `json:"snark_fees"`
+}
+
+// Total returns the income available for distribution.
+// Snark fees paid via coinbase are deducted from the coinbase amount.
+func (i EpochIncome) Total() types.Amount {
+	total := amountValue(i.Coinbase).Add(amountValue(i.Fees))
+	return saturatingSub(total, amountValue(i.SnarkFees))
+}
+
+// RewardPayout contains a delegator payout for a validator epoch
+type RewardPayout struct {
+	ID           int          `json:"-"`
+	Validator    string       `json:"validator"`
+	Epoch        int          `json:"epoch"`
+	Delegator    string       `json:"delegator"`
+	Balance      types.Amount `json:"balance"`
+	Share        float64      `json:"share"`
+	Reward       types.Amount `json:"reward"`
+	ValidatorFee float64      `json:"validator_fee"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+// TableName returns the model table name
+func (RewardPayout) TableName() string {
+	return "reward_payouts"
+}
+
+// RewardSummary contains the validator rewards distribution for an epoch
+type RewardSummary struct {
+	Validator         string         `json:"validator"`
+	Epoch             int            `json:"epoch"`
+	ValidatorFee      float64        `json:"validator_fee"`
+	Income            EpochIncome    `json:"income"`
+	TotalRewards      types.Amount   `json:"total_rewards"`
+	TotalStake        types.Amount   `json:"total_stake"`
+	ValidatorEarnings types.Amount   `json:"validator_earnings"`
+	Payouts           []RewardPayout `json:"payouts"`
+}
+
+// CalculateRewards distributes the validator epoch income between its delegators
+// proportionally to their staking ledger balance. The validator fee percentage is
+// withheld from every delegator payout except the validator's own stake.
+func CalculateRewards(validator string, epoch int, fee float64, income EpochIncome, entries []LedgerEntry) (*RewardSummary, error) {
+	if fee < 0 || fee > 100 {
+		return nil, errors.New("validator fee must be between 0 and 100")
+	}
+
+	// Validator fee in basis points to keep the calculation in integers
+	feeBasisPoints := big.NewInt(int64(math.Round(fee * 100)))
+	maxBasisPoints := big.NewInt(10000)
+
+	summary := &RewardSummary{
+		Validator:         validator,
+		Epoch:             epoch,
+		ValidatorFee:      fee,
+		Income:            income,
+		TotalRewards:      income.Total(),
+		TotalStake:        types.NewInt64Amount(0),
+		ValidatorEarnings: types.NewInt64Amount(0),
+		Payouts:           []RewardPayout{},
+	}
+
+	for _, entry := range entries {
+		summary.TotalStake = summary.TotalStake.Add(amountValue(entry.Balance))
+	}
+	if summary.TotalStake.Sign() == 0 {
+		return summary, nil
+	}
+
+	for _, entry := range entries {
+		balance := amountValue(entry.Balance)
+
+		gross := new(big.Int).Mul(summary.TotalRewards.Int, balance.Int)
+		gross.Quo(gross, summary.TotalStake.Int)
+
+		reward := new(big.Int).Set(gross)
+		if entry.PublicKey == validator {
+			summary.ValidatorEarnings = summary.ValidatorEarnings.Add(types.Amount{Int: gross})
+		} else {
+			reward.Mul(reward, new(big.Int).Sub(maxBasisPoints, feeBasisPoints))
+			reward.Quo(reward, maxBasisPoints)
+
+			withheld := new(big.Int).Sub(gross, reward)
+			summary.ValidatorEarnings = summary.ValidatorEarnings.Add(types.Amount{Int: withheld})
+		}
+
+		summary.Payouts = append(summary.Payouts, RewardPayout{
+			Validator:    validator,
+			Epoch:        epoch,
+			Delegator:    entry.PublicKey,
+			Balance:      balance,
+			Share:        balance.PercentOf(summary.TotalStake),
+			Reward:       types.Amount{Int: reward},
+			ValidatorFee: fee,
+		})
+	}
+
+	return summary, nil
+}
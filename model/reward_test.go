@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func TestEpochIncomeTotal(t *testing.T) {
+	income := EpochIncome{
+		Coinbase:  types.NewInt64Amount(1440),
+		Fees:      types.NewInt64Amount(60),
+		SnarkFees: types.NewInt64Amount(100),
+	}
+	assert.Equal(t, "1400", income.Total().String())
+	assert.Equal(t, "0", EpochIncome{}.Total().String())
+}
+
+func TestCalculateRewards(t *testing.T) {
+	income := EpochIncome{
+		BlocksProduced: 1,
+		Coinbase:       types.NewInt64Amount(1000),
+		Fees:           types.NewInt64Amount(0),
+	}
+	entries := []LedgerEntry{
+		{PublicKey: "validator", Delegate: "validator", Balance: types.NewInt64Amount(500)},
+		{PublicKey: "alice", Delegate: "validator", Balance: types.NewInt64Amount(300)},
+		{PublicKey: "bob", Delegate: "validator", Balance: types.NewInt64Amount(200)},
+	}
+
+	summary, err := CalculateRewards("validator", 5, 10, income, entries)
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", summary.TotalStake.String())
+	assert.Equal(t, "1000", summary.TotalRewards.String())
+	assert.Len(t, summary.Payouts, 3)
+
+	assert.Equal(t, "500", summary.Payouts[0].Reward.String())
+	assert.Equal(t, "270", summary.Payouts[1].Reward.String())
+	assert.Equal(t, "180", summary.Payouts[2].Reward.String())
+	assert.Equal(t, 30.0, summary.Payouts[1].Share)
+	assert.Equal(t, "550", summary.ValidatorEarnings.String())
+
+	_, err = CalculateRewards("validator", 5, 101, income, entries)
+	assert.Error(t, err)
+
+	summary, err = CalculateRewards("validator", 5, 0, income, nil)
+	assert.NoError(t, err)
+	assert.Len(t, summary.Payouts, 0)
+}
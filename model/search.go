@@ -0,0 +1,9 @@
+package model
+
+// SearchResult contains a single entity matching the search input
+type SearchResult struct {
+	Type  string  `json:"type"`
+	ID    string  `json:"id"`
+	Label *string `json:"label"`
+	Rank  float64 `json:"rank"`
+}
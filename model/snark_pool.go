@@ -0,0 +1,73 @@
+package model
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// SnarkPool contains a snapshot of the snark work market
+type SnarkPool struct {
+	ID                  int          `json:"-"`
+	Time                time.Time    `json:"time"`
+	PendingWorksCount   int          `json:"pending_works_count"`
+	CompletedWorksCount int          `json:"completed_works_count"`
+	ProversCount        int          `json:"provers_count"`
+	FeeMin              types.Amount `json:"fee_min"`
+	FeeMedian           types.Amount `json:"fee_median"`
+	FeeMax              types.Amount `json:"fee_max"`
+	CreatedAt           time.Time    `json:"-"`
+}
+
+// SnarkPoolStat contains the snark work market stats for a time bucket
+type SnarkPoolStat struct {
+	Time                time.Time    `json:"time"`
+	FeeMin              types.Amount `json:"fee_min"`
+	FeeMedian           types.Amount `json:"fee_median"`
+	FeeMax              types.Amount `json:"fee_max"`
+	ProversCount        int          `json:"provers_count"`
+	PendingWorksCount   int          `json:"pending_works_count"`
+	CompletedWorksCount int          `json:"completed_works_count"`
+}
+
+// TableName returns the model table name
+func (SnarkPool) TableName() string {
+	return "snark_pool"
+}
+
+// FeeDistribution returns the min, median and max of the given fees
+func FeeDistribution(fees []types.Amount) (min, median, max types.Amount) {
+	sorted := sortedAmounts(fees)
+	if len(sorted) == 0 {
+		zero := types.NewInt64Amount(0)
+		return zero, zero, zero
+	}
+
+	n := len(sorted)
+	median = sorted[n/2]
+	if n%2 == 0 {
+		sum := sorted[n/2-1].Add(sorted[n/2])
+		median = types.Amount{Int: sum.Div(sum.Int, big.NewInt(2))}
+	}
+
+	return sorted[0], median, sorted[n-1]
+}
+
+// sortedAmounts returns a sorted copy of the amounts, treating empty amounts as zero
+func sortedAmounts(amounts []types.Amount) []types.Amount {
+	sorted := make([]types.Amount, 0, len(amounts))
+	for _, amount := range amounts {
+		if amount.Int == nil {
+			amount = types.NewInt64Amount(0)
+		}
+		sorted = append(sorted, amount)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Compare(sorted[j]) < 0
+	})
+
+	return sorted
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func TestFeeDistribution(t *testing.T) {
+	examples := []struct {
+		fees   []types.Amount
+		min    string
+		median string
+		max    string
+	}{
+		{testAmounts(), "0", "0", "0"},
+		{testAmounts(5), "5", "5", "5"},
+		{testAmounts(30, 10, 20), "10", "20", "30"},
+		{testAmounts(40, 10, 30, 20), "10", "25", "40"},
+		{append(testAmounts(10, 20), types.Amount{}), "0", "10", "20"},
+	}
+
+	for _, ex := range examples {
+		min, median, max := FeeDistribution(ex.fees)
+		assert.Equal(t, ex.min, min.String())
+		assert.Equal(t, ex.median, median.String())
+		assert.Equal(t, ex.max, max.String())
+	}
+}
@@ -3,6 +3,8 @@ package model
 import (
 	"errors"
 	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
 )
 
 type Snarker struct {
@@ -19,6 +21,17 @@ type Snarker struct {
 	UpdatedAt   time.Time `json:"-"`
 }
 
+// SnarkerStat contains snarker job and earnings stats for a time bucket
+type SnarkerStat struct {
+	Time           string       `json:"time"`
+	Bucket         string       `json:"bucket"`
+	JobsCount      int          `json:"jobs_count"`
+	WorksCount     int          `json:"works_count"`
+	FeesAmount     types.Amount `json:"fees_amount"`
+	EarningsCount  int          `json:"earnings_count"`
+	EarningsAmount types.Amount `json:"earnings_amount"`
+}
+
 func (s Snarker) Validate() error {
 	if s.Account == "" {
 		return errors.New("public key is required")
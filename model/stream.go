@@ -0,0 +1,114 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// Stream event types
+	StreamEventBlock       = "block"
+	StreamEventTransaction = "transaction"
+	StreamEventCanonical   = "canonical"
+	StreamEventOrphan      = "orphan"
+
+	// StreamEventMaxSize is the max encoded event size, postgres limits the notification payload to 8000 bytes
+	StreamEventMaxSize = 7900
+)
+
+var (
+	StreamEventTypes = []string{
+		StreamEventBlock,
+		StreamEventTransaction,
+		StreamEventCanonical,
+		StreamEventOrphan,
+	}
+)
+
+// StreamEvent contains a newly indexed record published to the stream clients
+type StreamEvent struct {
+	Type      string          `json:"type"`
+	Hash      string          `json:"hash"`
+	BlockHash string          `json:"block_hash,omitempty"`
+	Height    uint64          `json:"height"`
+	Time      time.Time       `json:"time"`
+	TxType    string          `json:"tx_type,omitempty"`
+	Accounts  []string        `json:"accounts"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// NewBlockStreamEvent returns a new event for the indexed block
+func NewBlockStreamEvent(block Block) StreamEvent {
+	event := newStreamEvent(StreamEventBlock, block.Hash, "", block.Height, block.Time, block)
+	event.Accounts = []string{block.Creator}
+	return event
+}
+
+// NewCanonicalStreamEvent returns a new event for the block that became canonical
+func NewCanonicalStreamEvent(block Block) StreamEvent {
+	event := newStreamEvent(StreamEventCanonical, block.Hash, "", block.Height, block.Time, block)
+	event.Accounts = []string{block.Creator}
+	return event
+}
+
+// NewTransactionStreamEvent returns a new event for the canonical transaction
+func NewTransactionStreamEvent(tx Transaction) StreamEvent {
+	event := newStreamEvent(StreamEventTransaction, tx.Hash, tx.BlockHash, tx.BlockHeight, tx.Time, tx)
+	event.TxType = tx.Type
+	event.Accounts = []string{tx.Receiver}
+	if tx.Sender != nil && *tx.Sender != tx.Receiver {
+		event.Accounts = append(event.Accounts, *tx.Sender)
+	}
+	return event
+}
+
+// NewOrphanStreamEvent returns a new event for the block that is no longer canonical
+func NewOrphanStreamEvent(block Block) StreamEvent {
+	event := newStreamEvent(StreamEventOrphan, block.Hash, "", block.Height, block.Time, block)
+	event.Accounts = []string{block.Creator}
+	return event
+}
+
+// NewOrphanTransactionStreamEvent returns a new event for the transaction of an orphaned block
+func NewOrphanTransactionStreamEvent(tx Transaction) StreamEvent {
+	event := NewTransactionStreamEvent(tx)
+	event.Type = StreamEventOrphan
+	return event
+}
+
+func newStreamEvent(kind string, hash string, blockHash string, height uint64, ts time.Time, record interface{}) StreamEvent {
+	event := StreamEvent{
+		Type:      kind,
+		Hash:      hash,
+		BlockHash: blockHash,
+		Height:    height,
+		Time:      ts,
+	}
+
+	// Event is still usable without the record data
+	event.Data, _ = json.Marshal(record)
+
+	return event
+}
+
+// Encode returns the event JSON, the record data is dropped if the event is too large
+func (e StreamEvent) Encode() ([]byte, error) {
+	data, err := json.Marshal(e)
+	if err != nil || len(data) <= StreamEventMaxSize {
+		return data, err
+	}
+
+	e.Data = nil
+	return json.Marshal(e)
+}
+
+// Matches returns true if the event matches the account and types filters
+func (e StreamEvent) Matches(account string, types []string) bool {
+	if len(types) > 0 && !containsString(types, e.Type) {
+		return false
+	}
+	if account != "" && !containsString(e.Accounts, account) {
+		return false
+	}
+	return true
+}
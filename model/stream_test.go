@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamEventMatches(t *testing.T) {
+	sender := "B62qsender"
+	event := NewTransactionStreamEvent(Transaction{
+		Type:     TxTypePayment,
+		Hash:     "txhash",
+		Sender:   &sender,
+		Receiver: "B62qreceiver",
+	})
+
+	assert.Equal(t, []string{"B62qreceiver", "B62qsender"}, event.Accounts)
+	assert.True(t, event.Matches("", nil))
+	assert.True(t, event.Matches("B62qsender", nil))
+	assert.True(t, event.Matches("B62qreceiver", []string{StreamEventTransaction}))
+	assert.False(t, event.Matches("B62qother", nil))
+	assert.False(t, event.Matches("", []string{StreamEventBlock, StreamEventCanonical}))
+
+	block := NewBlockStreamEvent(Block{Hash: "blockhash", Creator: "B62qcreator"})
+	assert.True(t, block.Matches("B62qcreator", []string{StreamEventBlock}))
+	assert.False(t, block.Matches("B62qsender", nil))
+
+	orphan := NewOrphanTransactionStreamEvent(Transaction{Hash: "txhash", BlockHash: "blockhash", Receiver: "B62qreceiver"})
+	assert.Equal(t, "blockhash", orphan.BlockHash)
+	assert.True(t, orphan.Matches("B62qreceiver", []string{StreamEventOrphan}))
+	assert.False(t, orphan.Matches("", []string{StreamEventTransaction}))
+}
+
+func TestStreamEventEncode(t *testing.T) {
+	event := NewBlockStreamEvent(Block{Hash: "blockhash", Height: 10})
+
+	data, err := event.Encode()
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"data":{`)
+
+	event.Data = json.RawMessage(`"` + strings.Repeat("x", StreamEventMaxSize) + `"`)
+	data, err = event.Encode()
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), `"data"`)
+	assert.Contains(t, string(data), `"hash":"blockhash"`)
+	assert.Contains(t, string(data), `"height":10`)
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// Supply contains the currency supply details at a given block
+type Supply struct {
+	ID                int          `json:"-"`
+	Height            uint64       `json:"height"`
+	BlockHash         string       `json:"block_hash"`
+	Time              time.Time    `json:"time"`
+	Epoch             int          `json:"epoch"`
+	Slot              int          `json:"slot"`
+	TotalSupply       types.Amount `json:"total_supply"`
+	LockedSupply      types.Amount `json:"locked_supply"`
+	CirculatingSupply types.Amount `json:"circulating_supply"`
+	CreatedAt         time.Time    `json:"-"`
+}
+
+// SupplyStat contains the currency supply for a time bucket
+type SupplyStat struct {
+	Time              time.Time    `json:"time"`
+	TotalSupply       types.Amount `json:"total_supply"`
+	LockedSupply      types.Amount `json:"locked_supply"`
+	CirculatingSupply types.Amount `json:"circulating_supply"`
+}
+
+// TableName returns the model table name
+func (Supply) TableName() string {
+	return "supply"
+}
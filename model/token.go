@@ -0,0 +1,33 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultToken is the ID of the MINA token
+const DefaultToken uint64 = 1
+
+// Token contains the details of a token created on chain
+type Token struct {
+	ID        uint64    `json:"id"`
+	Owner     *string   `json:"owner"`
+	TxHash    *string   `json:"tx_hash"`
+	Height    uint64    `json:"height"`
+	Time      time.Time `json:"time"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// TableName returns the model table name
+func (Token) TableName() string {
+	return "tokens"
+}
+
+// Validate returns an error if token is invalid
+func (t Token) Validate() error {
+	if t.ID <= DefaultToken {
+		return errors.New("token id is invalid")
+	}
+	return nil
+}
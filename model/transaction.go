@@ -34,25 +34,31 @@ var (
 
 // Transaction contains the blockchain transaction details
 type Transaction struct {
-	ID                      int          `json:"id"`
-	Hash                    string       `json:"hash"`
-	Type                    string       `json:"type"`
-	BlockHash               string       `json:"block_hash"`
-	BlockHeight             uint64       `json:"block_height"`
-	Time                    time.Time    `json:"time"`
-	Sender                  *string      `json:"sender"`
-	Receiver                string       `json:"receiver"`
-	Amount                  types.Amount `json:"amount"`
-	Fee                     types.Amount `json:"fee"`
-	Nonce                   *int         `json:"nonce"`
-	Memo                    *string      `json:"memo"`
-	Status                  string       `json:"status"`
-	Canonical               bool         `json:"canonical"`
-	FailureReason           *string      `json:"failure_reason"`
-	SequenceNumber          *int         `json:"sequence_number"`
-	SecondarySequenceNumber *int         `json:"secondary_sequence_number"`
-	CreatedAt               time.Time    `json:"-"`
-	UpdatedAt               time.Time    `json:"-"`
+	ID                         int          `json:"id"`
+	Hash                       string       `json:"hash"`
+	Type                       string       `json:"type"`
+	BlockHash                  string       `json:"block_hash"`
+	BlockHeight                uint64       `json:"block_height"`
+	Time                       time.Time    `json:"time"`
+	Sender                     *string      `json:"sender"`
+	Receiver                   string       `json:"receiver"`
+	Amount                     types.Amount `json:"amount"`
+	Fee                        types.Amount `json:"fee"`
+	Token                      uint64       `json:"token"`
+	FeeToken                   uint64       `json:"fee_token"`
+	CreatedToken               *uint64      `json:"created_token"`
+	FeePayerAccountCreationFee types.Amount `json:"fee_payer_account_creation_fee"`
+	ReceiverAccountCreationFee types.Amount `json:"receiver_account_creation_fee"`
+	Nonce                      *int         `json:"nonce"`
+	Memo                       *string      `json:"memo"`
+	MemoRaw                    *string      `json:"memo_raw"`
+	Status                     string       `json:"status"`
+	Canonical                  bool         `json:"canonical"`
+	FailureReason              *string      `json:"failure_reason"`
+	SequenceNumber             *int         `json:"sequence_number"`
+	SecondarySequenceNumber    *int         `json:"secondary_sequence_number"`
+	CreatedAt                  time.Time    `json:"-"`
+	UpdatedAt                  time.Time    `json:"-"`
 }
 
 // TableName returns the model table name
@@ -6,14 +6,24 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"regexp"
+	"strings"
 )
 
 var (
 	errInvalidAmount = errors.New("invalid amount")
 
 	zero = new(big.Int)
+
+	// nanominaPerMina is the number of nanomina in a single MINA
+	nanominaPerMina = big.NewInt(1000000000)
+
+	reMINA = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]{0,9})?|\.[0-9]{1,9})$`)
 )
 
+// minaDecimals is the number of decimal places of a MINA amount
+const minaDecimals = 9
+
 // Amount represense a NEAR yocto
 type Amount struct {
 	*big.Int
@@ -37,11 +47,51 @@ func NewInt64Amount(val int64) Amount {
 	return Amount{Int: n}
 }
 
-func NewFloatAmount(val string) Amount {
-	var n float64
-	fmt.Sscanf(val, "%f", &n)
+// NewUInt64Amount returns a new amount for the given uint64 value
+func NewUInt64Amount(val uint64) Amount {
+	n := new(big.Int).SetUint64(val)
+	return Amount{Int: n}
+}
 
-	return NewInt64Amount(int64(n * 1000000000))
+// NewMINAAmount returns a new amount from the decimal MINA string, or zero if invalid
+func NewMINAAmount(val string) Amount {
+	amount, err := ParseMINA(val)
+	if err != nil {
+		return NewInt64Amount(0)
+	}
+	return amount
+}
+
+// ParseMINA returns an exact amount in nanomina from the decimal MINA string
+func ParseMINA(val string) (Amount, error) {
+	val = strings.TrimSpace(val)
+	if !reMINA.MatchString(val) {
+		return Amount{}, errInvalidAmount
+	}
+
+	negative := strings.HasPrefix(val, "-")
+	val = strings.TrimLeft(val, "+-")
+
+	parts := strings.SplitN(val, ".", 2)
+	if len(parts) == 1 {
+		parts = append(parts, "")
+	}
+	if parts[0] == "" {
+		parts[0] = "0"
+	}
+
+	// Pad the fraction to 9 decimal places to get the value in nanomina
+	digits := parts[0] + parts[1] + strings.Repeat("0", minaDecimals-len(parts[1]))
+
+	n, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Amount{}, errInvalidAmount
+	}
+	if negative {
+		n = n.Neg(n)
+	}
+
+	return Amount{Int: n}, nil
 }
 
 // MarshalJSON returns a JSON representation of amount
@@ -67,6 +117,26 @@ func (a Amount) String() string {
 	return a.Int.String()
 }
 
+// MINA returns the amount formatted in MINA, without trailing zeros
+func (a Amount) MINA() string {
+	if a.Int == nil {
+		return ""
+	}
+
+	abs := new(big.Int).Abs(a.Int)
+	whole, frac := new(big.Int).QuoRem(abs, nanominaPerMina, new(big.Int))
+
+	result := whole.String()
+	if frac.Sign() != 0 {
+		result += "." + strings.TrimRight(fmt.Sprintf("%09s", frac.String()), "0")
+	}
+	if a.Sign() < 0 {
+		result = "-" + result
+	}
+
+	return result
+}
+
 // Compare compares two amounts
 func (a Amount) Compare(b Amount) int {
 	return a.Cmp(b.Int)
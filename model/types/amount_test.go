@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountMINA(t *testing.T) {
+	examples := map[string]string{
+		"0":                   "0",
+		"1":                   "0.000000001",
+		"1000000000":          "1",
+		"1500000000":          "1.5",
+		"123456789012":        "123.456789012",
+		"-2500000000":         "-2.5",
+		"-1":                  "-0.000000001",
+		"1000000000000000000": "1000000000",
+	}
+
+	for input, expected := range examples {
+		assert.Equal(t, expected, NewAmount(input).MINA(), input)
+	}
+	assert.Equal(t, "", Amount{}.MINA())
+}
+
+func TestParseMINA(t *testing.T) {
+	examples := map[string]string{
+		"0":                      "0",
+		"1":                      "1000000000",
+		"1.":                     "1000000000",
+		"1.5":                    "1500000000",
+		"0.000000001":            "1",
+		".5":                     "500000000",
+		"66000.000000001":        "66000000000001",
+		"-2.25":                  "-2250000000",
+		"+3":                     "3000000000",
+		" 7.1 ":                  "7100000000",
+		"9007199254.740993":      "9007199254740993000",
+		"123456789012345.123456": "123456789012345123456000",
+	}
+
+	for input, expected := range examples {
+		amount, err := ParseMINA(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, amount.String(), input)
+	}
+
+	for _, input := range []string{"", ".", "-", "abc", "1.0000000001", "1e9", "1,5", "1.2.3"} {
+		_, err := ParseMINA(input)
+		assert.Error(t, err, input)
+	}
+
+	assert.Equal(t, "0", NewMINAAmount("invalid").String())
+}
+
+func TestMINARoundTrip(t *testing.T) {
+	// Formatting and parsing back must return the exact nanomina value
+	formatParse := func(n int64) bool {
+		amount := NewInt64Amount(n)
+		parsed, err := ParseMINA(amount.MINA())
+		return err == nil && parsed.Compare(amount) == 0
+	}
+	assert.NoError(t, quick.Check(formatParse, nil))
+
+	// Decimal strings with any number of decimals must match the integer arithmetic
+	parseDecimal := func(whole uint32, frac uint32, decimals uint8) bool {
+		decimals = decimals%minaDecimals + 1
+		fracStr := fmt.Sprintf("%0*d", int(decimals), uint64(frac)%pow10(decimals))
+
+		amount, err := ParseMINA(fmt.Sprintf("%d.%s", whole, fracStr))
+		if err != nil {
+			return false
+		}
+
+		fracVal, _ := new(big.Int).SetString(fracStr, 10)
+		expected := new(big.Int).Mul(big.NewInt(int64(whole)), nanominaPerMina)
+		expected.Add(expected, fracVal.Mul(fracVal, big.NewInt(int64(pow10(minaDecimals-decimals)))))
+
+		return amount.Int.Cmp(expected) == 0
+	}
+	assert.NoError(t, quick.Check(parseDecimal, nil))
+}
+
+func pow10(n uint8) uint64 {
+	result := uint64(1)
+	for i := uint8(0); i < n; i++ {
+		result *= 10
+	}
+	return result
+}
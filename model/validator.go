@@ -14,6 +14,7 @@ type Validator struct {
 	PublicKey      string       `json:"public_key"`
 	BlocksCreated  int          `json:"blocks_created"`
 	BlocksProposed int          `json:"blocks_proposed"`
+	BlocksOrphaned int          `json:"blocks_orphaned"`
 	Stake          types.Amount `json:"stake"`
 	Delegations    int          `json:"delegations"`
 	StartHeight    uint64       `json:"start_height"`
@@ -24,12 +25,32 @@ type Validator struct {
 	UpdatedAt      time.Time    `json:"-"`
 }
 
+// ValidatorSummary contains the validator details along with its account balance
+type ValidatorSummary struct {
+	PublicKey             string       `json:"public_key"`
+	IdentityName          *string      `json:"identity_name"`
+	StartHeight           uint64       `json:"start_height"`
+	StartTime             time.Time    `json:"start_time"`
+	LastHeight            uint64       `json:"last_height"`
+	LastTime              time.Time    `json:"last_time"`
+	BlocksCreated         int          `json:"blocks_created"`
+	BlocksProposed        int          `json:"blocks_proposed"`
+	BlocksOrphaned        int          `json:"blocks_orphaned"`
+	Delegations           int          `json:"delegations"`
+	Stake                 types.Amount `json:"stake"`
+	AccountBalance        types.Amount `json:"account_balance"`
+	AccountBalanceUnknown types.Amount `json:"account_balance_unknown"`
+}
+
 type ValidatorStat struct {
-	Time                string `json:"time"`
-	Bucket              string `json:"bucket"`
-	BlocksProducedCount int    `json:"blocks_produced_count"`
-	DelegationsCount    int    `json:"delegations_count"`
-	DelegationsAmount   string `json:"delegations_amount"`
+	Time                string       `json:"time"`
+	Bucket              string       `json:"bucket"`
+	BlocksProducedCount int          `json:"blocks_produced_count"`
+	DelegationsCount    int          `json:"delegations_count"`
+	DelegationsAmount   types.Amount `json:"delegations_amount"`
+	BlocksOrphanedCount int          `json:"blocks_orphaned_count"`
+	ExpectedBlocksCount float64      `json:"expected_blocks_count"`
+	PerformanceScore    float64      `json:"performance_score"`
 }
 
 // Validate returns an error if validator is invalid
@@ -0,0 +1,213 @@
+package model
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+// VestingUnlock contains the amount unlocked by timed accounts within an epoch
+type VestingUnlock struct {
+	Epoch     int          `json:"epoch"`
+	StartSlot int          `json:"start_slot"`
+	EndSlot   int          `json:"end_slot"`
+	Unlocked  types.Amount `json:"unlocked"`
+	Locked    types.Amount `json:"locked"`
+}
+
+// IsTimed returns true if the ledger entry has a vesting schedule
+func (e LedgerEntry) IsTimed() bool {
+	return e.TimingCliffTime != nil &&
+		e.TimingInitialMinimumBalance.Int != nil &&
+		e.TimingInitialMinimumBalance.Sign() > 0
+}
+
+// MinimumBalanceAt returns the balance that must remain locked at a given global slot.
+// It follows the timed account rules of the Mina protocol: the initial minimum balance
+// is locked until the cliff, the cliff amount unlocks at the cliff and the vesting
+// increment unlocks every vesting period afterwards.
+func (e LedgerEntry) MinimumBalanceAt(slot int) types.Amount {
+	if !e.IsTimed() {
+		return types.NewInt64Amount(0)
+	}
+
+	if slot < *e.TimingCliffTime {
+		return types.NewAmount(e.TimingInitialMinimumBalance.String())
+	}
+
+	period := intValue(e.TimingVestingPeriod)
+	if period == 0 {
+		return types.NewInt64Amount(0)
+	}
+
+	remaining := saturatingSub(e.TimingInitialMinimumBalance, amountValue(e.TimingCliffAmount))
+	periods := int64((slot - *e.TimingCliffTime) / period)
+	decrement := types.NewInt64Amount(periods).Mul(amountValue(e.TimingVestingIncrement))
+
+	return saturatingSub(remaining, decrement)
+}
+
+// LockedBalanceAt returns the locked and liquid parts of the balance at a given global slot
+func (e LedgerEntry) LockedBalanceAt(balance types.Amount, slot int) (locked types.Amount, liquid types.Amount) {
+	balance = amountValue(balance)
+	locked = e.MinimumBalanceAt(slot)
+
+	if locked.Compare(balance) > 0 {
+		locked = balance
+	}
+	liquid = balance.Sub(locked)
+
+	return
+}
+
+// FullyVestedSlot returns the first global slot at which nothing remains locked.
+// Returns false when the account never fully vests.
+func (e LedgerEntry) FullyVestedSlot() (int, bool) {
+	if !e.IsTimed() {
+		return 0, true
+	}
+
+	cliff := *e.TimingCliffTime
+	period := intValue(e.TimingVestingPeriod)
+	if period == 0 {
+		return cliff, true
+	}
+
+	remaining := saturatingSub(e.TimingInitialMinimumBalance, amountValue(e.TimingCliffAmount))
+	if remaining.Sign() == 0 {
+		return cliff, true
+	}
+
+	increment := amountValue(e.TimingVestingIncrement)
+	if increment.Sign() == 0 {
+		return 0, false
+	}
+
+	// Number of vesting periods required to unlock the remaining amount, rounded up
+	periods := new(big.Int).Add(remaining.Int, increment.Int)
+	periods.Sub(periods, big.NewInt(1))
+	periods.Quo(periods, increment.Int)
+
+	slot := new(big.Int).Mul(periods, big.NewInt(int64(period)))
+	slot.Add(slot, big.NewInt(int64(cliff)))
+	if !slot.IsInt64() || slot.Int64() > math.MaxInt32 {
+		return 0, false
+	}
+
+	return int(slot.Int64()), true
+}
+
+// VestingSchedule returns the per-epoch unlock schedule of the ledger entry
+func (e LedgerEntry) VestingSchedule() []VestingUnlock {
+	result := []VestingUnlock{}
+	if !e.IsTimed() {
+		return result
+	}
+
+	lastSlot, ok := e.FullyVestedSlot()
+	if !ok {
+		// Nothing unlocks after the cliff, so the schedule ends there
+		lastSlot = *e.TimingCliffTime
+	}
+
+	for epoch := *e.TimingCliffTime / SlotsPerEpoch; epoch <= lastSlot/SlotsPerEpoch; epoch++ {
+		startSlot := epoch * SlotsPerEpoch
+		endSlot := startSlot + SlotsPerEpoch - 1
+
+		before := e.MinimumBalanceAt(startSlot - 1)
+		after := e.MinimumBalanceAt(endSlot)
+
+		unlocked := before.Sub(after)
+		if unlocked.Sign() == 0 {
+			continue
+		}
+
+		result = append(result, VestingUnlock{
+			Epoch:     epoch,
+			StartSlot: startSlot,
+			EndSlot:   endSlot,
+			Unlocked:  unlocked,
+			Locked:    after,
+		})
+	}
+
+	return result
+}
+
+// LockedSupplyAt returns the total locked balance of all entries at a given global slot
+func LockedSupplyAt(entries []LedgerEntry, slot int) types.Amount {
+	result := types.NewInt64Amount(0)
+
+	for _, entry := range entries {
+		locked, _ := entry.LockedBalanceAt(entry.Balance, slot)
+		result = result.Add(locked)
+	}
+
+	return result
+}
+
+// NetworkVestingSchedule returns the combined per-epoch unlock schedule for all entries
+func NetworkVestingSchedule(entries []LedgerEntry) []VestingUnlock {
+	epochs := map[int]*VestingUnlock{}
+	locked := types.NewInt64Amount(0)
+
+	for _, entry := range entries {
+		if !entry.IsTimed() {
+			continue
+		}
+		locked = locked.Add(entry.MinimumBalanceAt(-1))
+
+		for _, unlock := range entry.VestingSchedule() {
+			item, ok := epochs[unlock.Epoch]
+			if !ok {
+				item = &VestingUnlock{
+					Epoch:     unlock.Epoch,
+					StartSlot: unlock.StartSlot,
+					EndSlot:   unlock.EndSlot,
+					Unlocked:  types.NewInt64Amount(0),
+				}
+				epochs[unlock.Epoch] = item
+			}
+			item.Unlocked = item.Unlocked.Add(unlock.Unlocked)
+		}
+	}
+
+	result := make([]VestingUnlock, 0, len(epochs))
+	for _, item := range epochs {
+		result = append(result, *item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Epoch < result[j].Epoch
+	})
+
+	// Locked amount at the end of each epoch
+	for idx := range result {
+		locked = locked.Sub(result[idx].Unlocked)
+		result[idx].Locked = locked
+	}
+
+	return result
+}
+
+func saturatingSub(a, b types.Amount) types.Amount {
+	if a.Compare(b) <= 0 {
+		return types.NewInt64Amount(0)
+	}
+	return a.Sub(b)
+}
+
+func amountValue(a types.Amount) types.Amount {
+	if a.Int == nil {
+		return types.NewInt64Amount(0)
+	}
+	return a
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func timedEntry(initial, cliffAmount, increment int64, cliffTime, period int) LedgerEntry {
+	return LedgerEntry{
+		TimingInitialMinimumBalance: types.NewInt64Amount(initial),
+		TimingCliffAmount:           types.NewInt64Amount(cliffAmount),
+		TimingVestingIncrement:      types.NewInt64Amount(increment),
+		TimingCliffTime:             &cliffTime,
+		TimingVestingPeriod:         &period,
+	}
+}
+
+func TestMinimumBalanceAt(t *testing.T) {
+	entry := timedEntry(1000, 400, 100, 10, 5)
+
+	examples := map[int]string{
+		0:   "1000",
+		9:   "1000",
+		10:  "600",
+		14:  "600",
+		15:  "500",
+		30:  "200",
+		40:  "0",
+		100: "0",
+	}
+	for slot, expected := range examples {
+		assert.Equal(t, expected, entry.MinimumBalanceAt(slot).String(), "slot %d", slot)
+	}
+
+	assert.Equal(t, "0", LedgerEntry{}.MinimumBalanceAt(0).String())
+	assert.Equal(t, "0", timedEntry(1000, 0, 0, 10, 0).MinimumBalanceAt(10).String())
+}
+
+func TestLockedBalanceAt(t *testing.T) {
+	entry := timedEntry(1000, 400, 100, 10, 5)
+
+	locked, liquid := entry.LockedBalanceAt(types.NewInt64Amount(1500), 15)
+	assert.Equal(t, "500", locked.String())
+	assert.Equal(t, "1000", liquid.String())
+
+	locked, liquid = entry.LockedBalanceAt(types.NewInt64Amount(300), 0)
+	assert.Equal(t, "300", locked.String())
+	assert.Equal(t, "0", liquid.String())
+}
+
+func TestFullyVestedSlot(t *testing.T) {
+	slot, ok := timedEntry(1000, 400, 100, 10, 5).FullyVestedSlot()
+	assert.True(t, ok)
+	assert.Equal(t, 40, slot)
+
+	slot, ok = timedEntry(1000, 400, 150, 10, 5).FullyVestedSlot()
+	assert.True(t, ok)
+	assert.Equal(t, 30, slot)
+
+	_, ok = timedEntry(1000, 400, 0, 10, 5).FullyVestedSlot()
+	assert.False(t, ok)
+}
+
+func TestVestingSchedule(t *testing.T) {
+	entry := timedEntry(1000, 400, 100, SlotsPerEpoch-1, SlotsPerEpoch)
+
+	schedule := entry.VestingSchedule()
+	assert.Len(t, schedule, 7)
+	assert.Equal(t, 0, schedule[0].Epoch)
+	assert.Equal(t, "400", schedule[0].Unlocked.String())
+	assert.Equal(t, "600", schedule[0].Locked.String())
+	assert.Equal(t, 6, schedule[6].Epoch)
+	assert.Equal(t, "0", schedule[6].Locked.String())
+
+	network := NetworkVestingSchedule([]LedgerEntry{entry, entry, {}})
+	assert.Len(t, network, 7)
+	assert.Equal(t, "800", network[0].Unlocked.String())
+	assert.Equal(t, "1200", network[0].Locked.String())
+	assert.Equal(t, "0", network[6].Locked.String())
+}
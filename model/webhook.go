@@ -0,0 +1,171 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+const (
+	// Webhook event types
+	WebhookEventTransaction = "transaction"
+	WebhookEventBlock       = "block"
+	WebhookEventDelegation  = "delegation"
+	WebhookEventReorg       = "reorg"
+
+	// Webhook delivery statuses
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	WebhookStatusFailed    = "failed"
+
+	// WebhookMaxAttempts is the number of attempts before the delivery is marked as failed
+	WebhookMaxAttempts = 8
+
+	webhookBackoffBase = 30 * time.Second
+	webhookBackoffMax  = 6 * time.Hour
+)
+
+var (
+	WebhookEvents = []string{
+		WebhookEventTransaction,
+		WebhookEventBlock,
+		WebhookEventDelegation,
+		WebhookEventReorg,
+	}
+)
+
+// WebhookSubscription contains the webhook endpoint and the events filters
+type WebhookSubscription struct {
+	ID        int            `json:"id"`
+	URL       string         `json:"url"`
+	Secret    string         `json:"-"`
+	Events    pq.StringArray `json:"events"`
+	Account   *string        `json:"account"`
+	Validator *string        `json:"validator"`
+	TxType    *string        `json:"tx_type"`
+	MinAmount types.Amount   `json:"min_amount"`
+	Active    bool           `json:"active"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TableName returns the model table name
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Validate returns an error if subscription is invalid
+func (s WebhookSubscription) Validate() error {
+	endpoint, err := url.Parse(s.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return errors.New("url is invalid")
+	}
+	if s.Secret == "" {
+		return errors.New("secret is required")
+	}
+	if s.Account == nil && s.Validator == nil {
+		return errors.New("account or validator is required")
+	}
+	for _, event := range s.Events {
+		if !containsString(WebhookEvents, event) {
+			return errors.New("invalid event: " + event)
+		}
+	}
+	if s.TxType != nil && !containsString(TxTypes, *s.TxType) {
+		return errors.New("invalid transaction type: " + *s.TxType)
+	}
+	return nil
+}
+
+// WebhookDelivery contains the event payload and its delivery status
+type WebhookDelivery struct {
+	ID             int             `json:"id"`
+	SubscriptionID int             `json:"subscription_id"`
+	Event          string          `json:"event"`
+	EventKey       string          `json:"event_key"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseCode   *int            `json:"response_code"`
+	Error          *string         `json:"error"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// TableName returns the model table name
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Succeed marks the delivery as delivered
+func (d *WebhookDelivery) Succeed(code int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookStatusDelivered
+	d.ResponseCode = &code
+	d.Error = nil
+	d.DeliveredAt = &now
+}
+
+// Fail records the failed attempt and schedules the next one with exponential backoff
+func (d *WebhookDelivery) Fail(code int, err error, now time.Time) {
+	d.Attempts++
+
+	d.ResponseCode = nil
+	if code > 0 {
+		d.ResponseCode = &code
+	}
+
+	d.Error = nil
+	if err != nil {
+		msg := err.Error()
+		d.Error = &msg
+	}
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookStatusFailed
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookBackoff(d.Attempts))
+}
+
+// WebhookBackoff returns the delay before the next delivery attempt
+func WebhookBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := webhookBackoffBase
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookBackoffMax {
+			return webhookBackoffMax
+		}
+	}
+	return delay
+}
+
+// SignWebhookPayload returns the hex encoded HMAC-SHA256 signature of the payload
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
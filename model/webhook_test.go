@@ -0,0 +1,74 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, WebhookBackoff(0))
+	assert.Equal(t, 30*time.Second, WebhookBackoff(1))
+	assert.Equal(t, time.Minute, WebhookBackoff(2))
+	assert.Equal(t, 8*time.Minute, WebhookBackoff(5))
+	assert.Equal(t, 6*time.Hour, WebhookBackoff(20))
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	assert.Equal(t,
+		"f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		SignWebhookPayload("key", []byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
+
+func TestWebhookDeliveryAttempts(t *testing.T) {
+	now := time.Now()
+	delivery := WebhookDelivery{Status: WebhookStatusPending}
+
+	delivery.Fail(500, errors.New("server error"), now)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, WebhookStatusPending, delivery.Status)
+	assert.Equal(t, 500, *delivery.ResponseCode)
+	assert.Equal(t, now.Add(30*time.Second), delivery.NextAttemptAt)
+
+	for delivery.Attempts < WebhookMaxAttempts {
+		delivery.Fail(0, errors.New("timeout"), now)
+	}
+	assert.Equal(t, WebhookStatusFailed, delivery.Status)
+	assert.Nil(t, delivery.ResponseCode)
+
+	delivery = WebhookDelivery{Status: WebhookStatusPending}
+	delivery.Succeed(200, now)
+	assert.Equal(t, WebhookStatusDelivered, delivery.Status)
+	assert.Equal(t, now, *delivery.DeliveredAt)
+	assert.Nil(t, delivery.Error)
+}
+
+func TestWebhookSubscriptionValidate(t *testing.T) {
+	account := "B62qaccount"
+	txType := "unknown"
+
+	sub := WebhookSubscription{}
+	assert.EqualError(t, sub.Validate(), "url is invalid")
+
+	sub.URL = "ftp://example.com"
+	assert.EqualError(t, sub.Validate(), "url is invalid")
+
+	sub.URL = "https://example.com/hook"
+	assert.EqualError(t, sub.Validate(), "secret is required")
+
+	sub.Secret = "secret"
+	assert.EqualError(t, sub.Validate(), "account or validator is required")
+
+	sub.Account = &account
+	assert.NoError(t, sub.Validate())
+
+	sub.Events = []string{WebhookEventBlock, "other"}
+	assert.EqualError(t, sub.Validate(), "invalid event: other")
+
+	sub.Events = []string{WebhookEventBlock}
+	sub.TxType = &txType
+	assert.EqualError(t, sub.Validate(), "invalid transaction type: unknown")
+}
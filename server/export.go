@@ -0,0 +1,231 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/figment-networks/mina-indexer/model"
+)
+
+const (
+	exportFormatJSON   = "json"
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+)
+
+var (
+	transactionExportHeader = []string{
+		"id", "hash", "type", "block_height", "block_hash", "time", "canonical", "status",
+		"sender", "receiver", "token", "amount", "fee", "nonce", "memo", "failure_reason",
+	}
+
+	rewardExportHeader = []string{
+		"validator", "epoch", "delegator", "balance", "share", "reward", "validator_fee",
+	}
+)
+
+type exportParams struct {
+	Format string `form:"format"`
+}
+
+func (p *exportParams) validate() error {
+	switch p.Format {
+	case "":
+		p.Format = exportFormatJSON
+	case exportFormatJSON, exportFormatCSV, exportFormatNDJSON:
+	default:
+		return errors.New("invalid format: " + p.Format)
+	}
+	return nil
+}
+
+func (p exportParams) isStream() bool {
+	return p.Format != exportFormatJSON
+}
+
+// exportRow is a single record of the exported data
+type exportRow interface {
+	csvRecord() []string
+}
+
+// transactionExportRow contains the exported transaction with amounts in MINA
+type transactionExportRow struct {
+	ID            int     `json:"id"`
+	Hash          string  `json:"hash"`
+	Type          string  `json:"type"`
+	BlockHeight   uint64  `json:"block_height"`
+	BlockHash     string  `json:"block_hash"`
+	Time          string  `json:"time"`
+	Canonical     bool    `json:"canonical"`
+	Status        string  `json:"status"`
+	Sender        *string `json:"sender"`
+	Receiver      string  `json:"receiver"`
+	Token         uint64  `json:"token"`
+	Amount        string  `json:"amount"`
+	Fee           string  `json:"fee"`
+	Nonce         *int    `json:"nonce"`
+	Memo          *string `json:"memo"`
+	FailureReason *string `json:"failure_reason"`
+}
+
+func newTransactionExportRow(tx model.Transaction) transactionExportRow {
+	return transactionExportRow{
+		ID:            tx.ID,
+		Hash:          tx.Hash,
+		Type:          tx.Type,
+		BlockHeight:   tx.BlockHeight,
+		BlockHash:     tx.BlockHash,
+		Time:          tx.Time.UTC().Format(time.RFC3339),
+		Canonical:     tx.Canonical,
+		Status:        tx.Status,
+		Sender:        tx.Sender,
+		Receiver:      tx.Receiver,
+		Token:         tx.Token,
+		Amount:        tx.Amount.MINA(),
+		Fee:           tx.Fee.MINA(),
+		Nonce:         tx.Nonce,
+		Memo:          tx.Memo,
+		FailureReason: tx.FailureReason,
+	}
+}
+
+func (r transactionExportRow) csvRecord() []string {
+	nonce := ""
+	if r.Nonce != nil {
+		nonce = strconv.Itoa(*r.Nonce)
+	}
+
+	return []string{
+		strconv.Itoa(r.ID),
+		r.Hash,
+		r.Type,
+		strconv.FormatUint(r.BlockHeight, 10),
+		r.BlockHash,
+		r.Time,
+		strconv.FormatBool(r.Canonical),
+		r.Status,
+		stringOrEmpty(r.Sender),
+		r.Receiver,
+		strconv.FormatUint(r.Token, 10),
+		r.Amount,
+		r.Fee,
+		nonce,
+		stringOrEmpty(r.Memo),
+		stringOrEmpty(r.FailureReason),
+	}
+}
+
+// rewardExportRow contains the exported delegator payout with amounts in MINA
+type rewardExportRow struct {
+	Validator    string  `json:"validator"`
+	Epoch        int     `json:"epoch"`
+	Delegator    string  `json:"delegator"`
+	Balance      string  `json:"balance"`
+	Share        float64 `json:"share"`
+	Reward       string  `json:"reward"`
+	ValidatorFee float64 `json:"validator_fee"`
+}
+
+func newRewardExportRow(p model.RewardPayout) rewardExportRow {
+	return rewardExportRow{
+		Validator:    p.Validator,
+		Epoch:        p.Epoch,
+		Delegator:    p.Delegator,
+		Balance:      p.Balance.MINA(),
+		Share:        p.Share,
+		Reward:       p.Reward.MINA(),
+		ValidatorFee: p.ValidatorFee,
+	}
+}
+
+func (r rewardExportRow) csvRecord() []string {
+	return []string{
+		r.Validator,
+		strconv.Itoa(r.Epoch),
+		r.Delegator,
+		r.Balance,
+		strconv.FormatFloat(r.Share, 'f', -1, 64),
+		r.Reward,
+		strconv.FormatFloat(r.ValidatorFee, 'f', -1, 64),
+	}
+}
+
+// streamExport writes the rows produced by the iterator directly into the response.
+// Errors can't be rendered once the response has started, so an error row is
+// appended instead to make the truncated output detectable.
+func streamExport(c *gin.Context, format string, name string, header []string, iterate func(write func(exportRow) error) error) {
+	var write func(exportRow) error
+	var writeError func(error) error
+	var flush func() error
+
+	switch format {
+	case exportFormatCSV:
+		c.Header("Content-Type", "text/csv")
+
+		w := csv.NewWriter(c.Writer)
+		w.Write(header)
+
+		write = func(row exportRow) error {
+			return w.Write(csvSafeRecord(row.csvRecord()))
+		}
+		writeError = func(err error) error {
+			return w.Write(csvSafeRecord([]string{"error", err.Error()}))
+		}
+		flush = func() error {
+			w.Flush()
+			return w.Error()
+		}
+	case exportFormatNDJSON:
+		c.Header("Content-Type", "application/x-ndjson")
+
+		enc := json.NewEncoder(c.Writer)
+
+		write = func(row exportRow) error {
+			return enc.Encode(row)
+		}
+		writeError = func(err error) error {
+			return enc.Encode(gin.H{"error": err.Error()})
+		}
+		flush = func() error {
+			return nil
+		}
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", name, format))
+	c.Status(200)
+
+	if err := iterate(write); err != nil {
+		c.Error(err)
+
+		if err := writeError(err); err != nil {
+			c.Error(err)
+		}
+	}
+	if err := flush(); err != nil {
+		c.Error(err)
+	}
+}
+
+// csvSafeRecord prefixes the cells that spreadsheets would evaluate as formulas
+func csvSafeRecord(record []string) []string {
+	for i, val := range record {
+		if val != "" && strings.ContainsAny(val[:1], "=+-@\t\r") {
+			record[i] = "'" + val
+		}
+	}
+	return record
+}
+
+func stringOrEmpty(val *string) string {
+	if val == nil {
+		return ""
+	}
+	return *val
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	memo := "=HYPERLINK(\"http://example.com\")"
+	row := transactionExportRow{ID: 1, Hash: "hash", Receiver: "receiver", Memo: &memo}
+
+	examples := []struct {
+		format string
+		body   string
+	}{
+		{
+			format: exportFormatCSV,
+			body: "id,hash\n" +
+				"1,hash,,0,,,false,,,receiver,0,,,,\"'=HYPERLINK(\"\"http://example.com\"\")\",\n" +
+				"error,failed\n",
+		},
+		{
+			format: exportFormatNDJSON,
+			body: `{"id":1,"hash":"hash","type":"","block_height":0,"block_hash":"","time":"","canonical":false,"status":"","sender":null,"receiver":"receiver","token":0,"amount":"","fee":"","nonce":null,"memo":"=HYPERLINK(\"http://example.com\")","failure_reason":null}` + "\n" +
+				`{"error":"failed"}` + "\n",
+		},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.format, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(resp)
+
+			streamExport(c, ex.format, "transactions", []string{"id", "hash"}, func(write func(exportRow) error) error {
+				if err := write(row); err != nil {
+					return err
+				}
+				return errors.New("failed")
+			})
+
+			assert.Equal(t, 200, resp.Code)
+			assert.Equal(t, ex.body, resp.Body.String())
+			assert.Len(t, c.Errors, 1)
+		})
+	}
+}
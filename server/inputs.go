@@ -2,8 +2,12 @@ package server
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/figment-networks/mina-indexer/model"
 )
 
 type blockTimesParams struct {
@@ -11,7 +15,10 @@ type blockTimesParams struct {
 }
 
 type accountsIndexParams struct {
-	Height int64 `form:"height"`
+	Height int64  `form:"height"`
+	Time   string `form:"time"`
+
+	time *time.Time
 }
 
 func (p *blockTimesParams) setDefaults() {
@@ -23,6 +30,224 @@ func (p *blockTimesParams) setDefaults() {
 	}
 }
 
+type topAccountsParams struct {
+	Limit uint `form:"limit"`
+}
+
+func (p *topAccountsParams) setDefaults() {
+	if p.Limit == 0 {
+		p.Limit = 100
+	}
+	if p.Limit > 1000 {
+		p.Limit = 1000
+	}
+}
+
+type epochsParams struct {
+	Limit uint `form:"limit"`
+}
+
+func (p *epochsParams) setDefaults() {
+	if p.Limit == 0 {
+		p.Limit = 50
+	}
+	if p.Limit > 500 {
+		p.Limit = 500
+	}
+}
+
+func (p *accountsIndexParams) validate() error {
+	if p.Height < 0 {
+		return errors.New("height must be greater than 0")
+	}
+	if p.Time != "" {
+		if p.Height > 0 {
+			return errors.New("can't use both height and time")
+		}
+		t, err := time.Parse(time.RFC3339, p.Time)
+		if err != nil {
+			return errors.New("time is invalid")
+		}
+		p.time = &t
+	}
+	return nil
+}
+
+type vestingParams struct {
+	Slot *int `form:"slot"`
+}
+
+func (p *vestingParams) validate() error {
+	if p.Slot != nil && *p.Slot < 0 {
+		return errors.New("slot must be greater than 0")
+	}
+	return nil
+}
+
+type ledgersDiffParams struct {
+	From  *int `form:"from"`
+	To    *int `form:"to"`
+	Limit uint `form:"limit"`
+}
+
+func (p *ledgersDiffParams) validate() error {
+	if p.From == nil || p.To == nil {
+		return errors.New("from and to epochs are required")
+	}
+	if *p.From < 0 || *p.To < 0 {
+		return errors.New("epoch must be greater than 0")
+	}
+	if *p.From == *p.To {
+		return errors.New("from and to epochs must be different")
+	}
+
+	if p.Limit == 0 {
+		p.Limit = 1000
+	}
+	if p.Limit > 10000 {
+		p.Limit = 10000
+	}
+
+	return nil
+}
+
+type searchParams struct {
+	Query string `form:"q"`
+	Limit uint   `form:"limit"`
+}
+
+func (p *searchParams) validate() error {
+	p.Query = strings.TrimSpace(p.Query)
+	if p.Query == "" {
+		return errors.New("search query is required")
+	}
+	if len(p.Query) > 256 {
+		return errors.New("search query is too long")
+	}
+
+	if p.Limit == 0 {
+		p.Limit = 20
+	}
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+
+	return nil
+}
+
+type feesEstimateParams struct {
+	Blocks uint `form:"blocks"`
+}
+
+func (p *feesEstimateParams) setDefaults() {
+	if p.Blocks == 0 {
+		p.Blocks = 10
+	}
+	if p.Blocks > 100 {
+		p.Blocks = 100
+	}
+}
+
+type snarkerJobsParams struct {
+	Page  uint `form:"page"`
+	Limit uint `form:"limit"`
+}
+
+func (p *snarkerJobsParams) setDefaults() {
+	if p.Page == 0 {
+		p.Page = 1
+	}
+	if p.Limit == 0 {
+		p.Limit = 50
+	}
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+}
+
+type forksParams struct {
+	From  string `form:"from"`
+	To    string `form:"to"`
+	Limit uint   `form:"limit"`
+
+	from time.Time
+	to   time.Time
+}
+
+func (p *forksParams) validate() error {
+	p.to = time.Now()
+	if p.To != "" {
+		t, err := time.Parse(time.RFC3339, p.To)
+		if err != nil {
+			return errors.New("to time is invalid")
+		}
+		p.to = t
+	}
+
+	p.from = p.to.Add(-24 * time.Hour)
+	if p.From != "" {
+		t, err := time.Parse(time.RFC3339, p.From)
+		if err != nil {
+			return errors.New("from time is invalid")
+		}
+		p.from = t
+	}
+
+	if p.from.After(p.to) {
+		return errors.New("from time must be before to time")
+	}
+
+	if p.Limit == 0 {
+		p.Limit = 100
+	}
+	if p.Limit > 200 {
+		p.Limit = 200
+	}
+
+	return nil
+}
+
+type orphansParams struct {
+	Creator   string `form:"creator"`
+	MaxHeight uint64 `form:"max_height"`
+	Limit     uint   `form:"limit"`
+}
+
+func (p *orphansParams) setDefaults() {
+	if p.Limit == 0 {
+		p.Limit = 100
+	}
+	if p.Limit > 1000 {
+		p.Limit = 1000
+	}
+}
+
+type performanceParams struct {
+	Epoch *int `form:"epoch"`
+}
+
+func (p *performanceParams) validate() error {
+	if p.Epoch != nil && *p.Epoch < 0 {
+		return errors.New("epoch must be greater than 0")
+	}
+	return nil
+}
+
+type rewardsParams struct {
+	Epoch *int    `form:"epoch"`
+	Fee   float64 `form:"fee"`
+}
+
+func (p *rewardsParams) validate() error {
+	if p.Epoch != nil && *p.Epoch < 0 {
+		return errors.New("epoch must be greater than 0")
+	}
+	if p.Fee < 0 || p.Fee > 100 {
+		return errors.New("fee must be between 0 and 100")
+	}
+	return nil
+}
+
 type timeBucket struct {
 	Interval string `form:"interval"`
 	Period   uint   `form:"period"`
@@ -62,3 +287,56 @@ func getTimeBucket(c *gin.Context) (t timeBucket, err error) {
 	err = t.validate()
 	return
 }
+
+type webhookDeliveriesParams struct {
+	Status string `form:"status"`
+	Page   uint   `form:"page"`
+	Limit  uint   `form:"limit"`
+}
+
+func (p *webhookDeliveriesParams) validate() error {
+	switch p.Status {
+	case "", model.WebhookStatusPending, model.WebhookStatusDelivered, model.WebhookStatusFailed:
+	default:
+		return errors.New("invalid status: " + p.Status)
+	}
+
+	if p.Page == 0 {
+		p.Page = 1
+	}
+	if p.Limit == 0 {
+		p.Limit = 50
+	}
+	if p.Limit > 100 {
+		p.Limit = 100
+	}
+	return nil
+}
+
+type streamParams struct {
+	Account string `form:"account"`
+	Type    string `form:"type"`
+
+	types []string
+}
+
+func (p *streamParams) validate() error {
+	if p.Type == "" {
+		return nil
+	}
+
+	for _, t := range strings.Split(strings.ToLower(p.Type), ",") {
+		found := false
+		for _, existing := range model.StreamEventTypes {
+			if existing == t {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("invalid event type: " + t)
+		}
+		p.types = append(p.types, t)
+	}
+	return nil
+}
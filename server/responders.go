@@ -51,6 +51,9 @@ func jsonOk(c *gin.Context, data interface{}) {
 		c.Header("Content-Type", "application/json")
 		c.String(200, "%s", data)
 	default:
+		if c.GetString("units") == unitsMina {
+			data = minaUnits(data)
+		}
 		c.JSON(200, data)
 	}
 }
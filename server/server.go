@@ -11,6 +11,7 @@ import (
 	"github.com/figment-networks/mina-indexer/client/graph"
 	"github.com/figment-networks/mina-indexer/config"
 	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
 	"github.com/figment-networks/mina-indexer/store"
 )
 
@@ -21,6 +22,7 @@ type Server struct {
 	graphClient *graph.Client
 	db          *store.Store
 	log         *logrus.Logger
+	stream      *streamHub
 }
 
 // New returns a new server instance
@@ -31,6 +33,7 @@ func New(db *store.Store, cfg *config.Config, logger *logrus.Logger) *Server {
 		db:          db,
 		graphClient: graph.NewDefaultClient(cfg.MinaEndpoint),
 		log:         logger,
+		stream:      newStreamHub(cfg.DatabaseURL, logger),
 	}
 
 	s.initMiddleware(cfg)
@@ -47,26 +50,55 @@ func (s *Server) initRoutes() {
 	s.GET("/blocks", s.GetBlocks)
 	s.GET("/blocks/:id", s.GetBlock)
 	s.GET("/blocks/:id/transactions", s.GetBlockTransactions)
+	s.GET("/blocks/:id/siblings", s.GetBlockSiblings)
+	s.GET("/forks", s.GetForks)
+	s.GET("/orphans", s.GetOrphans)
 	s.GET("/block_times", s.GetBlockTimes)
 	s.GET("/block_stats", timeBucketMiddleware(), s.GetBlockStats)
 	s.GET("/chain_stats", timeBucketMiddleware(), s.GetBlockStats)
 	s.GET("/validators", s.GetValidators)
 	s.GET("/validators/:id", s.GetValidator)
 	s.GET("/validators/:id/stats", timeBucketMiddleware(), s.GetValidatorStats)
+	s.GET("/validators/:id/rewards", s.GetValidatorRewards)
+	s.GET("/validators/:id/performance", timeBucketMiddleware(), s.GetValidatorPerformance)
+	s.GET("/validators/:id/delegators/changes", s.GetValidatorDelegatorChanges)
 	s.GET("/delegations", s.GetDelegations)
 	s.GET("/snarkers", s.GetSnarkers)
 	s.GET("/snarker/:id", s.GetSnarker)
+	s.GET("/snarkers/:id/jobs", s.GetSnarkerJobs)
+	s.GET("/snarkers/:id/earnings", timeBucketMiddleware(), s.GetSnarkerEarnings)
+	s.GET("/snarks/market", timeBucketMiddleware(), s.GetSnarksMarket)
 	s.GET("/transactions", s.GetTransactions)
 	s.GET("/pending_transactions", s.GetPendingTransactions)
+	s.GET("/fees/estimate", s.GetFeesEstimate)
 	s.GET("/transactions/:id", s.GetTransaction)
+	s.GET("/accounts", s.GetAccounts)
+	s.GET("/accounts/top", s.GetTopAccounts)
 	s.GET("/accounts/:id", s.GetAccount)
+	s.GET("/accounts/:id/vesting", s.GetAccountVesting)
+	s.GET("/accounts/:id/transactions", s.GetAccountTransactions)
+	s.GET("/accounts/:id/delegation_history", s.GetAccountDelegationHistory)
+	s.GET("/supply", s.GetSupply)
+	s.GET("/supply/history", timeBucketMiddleware(), s.GetSupplyHistory)
+	s.GET("/supply/vesting", s.GetSupplyVesting)
+	s.GET("/epochs", s.GetEpochs)
+	s.GET("/epochs/:id", s.GetEpoch)
+	s.GET("/epochs/:id/validators", s.GetEpochValidators)
 	s.GET("/ledgers", s.GetLedgers)
+	s.GET("/ledgers/diff", s.GetLedgersDiff)
 	s.GET("/ledger", s.GetLedger)
+	s.GET("/tokens", s.GetTokens)
+	s.GET("/tokens/:id", s.GetToken)
+	s.GET("/search", s.GetSearch)
+	s.GET("/webhooks/:id/deliveries", s.GetWebhookDeliveries)
+	s.GET("/stream", s.GetStream)
+	s.GET("/ws", s.GetWebsocket)
 }
 
 func (s *Server) initMiddleware(cfg *config.Config) {
 	s.Use(gin.Recovery())
 	s.Use(requestLoggerMiddleware(logrus.StandardLogger()))
+	s.Use(unitsMiddleware())
 
 	if cfg.IsDevelopment() {
 		s.Use(corsMiddleware())
@@ -223,6 +255,57 @@ func (s *Server) GetBlockTransactions(c *gin.Context) {
 	jsonOk(c, transactions)
 }
 
+// GetBlockSiblings returns all blocks indexed at the same height
+func (s *Server) GetBlockSiblings(c *gin.Context) {
+	var height uint64
+
+	id := resourceID(c, "id")
+	if id.IsNumeric() {
+		if id.UInt64() == 0 {
+			badRequest(c, errors.New("height must be greater than 0"))
+			return
+		}
+		height = id.UInt64()
+	} else {
+		block, err := s.db.Blocks.FindByHash(id.String())
+		if shouldReturn(c, err) {
+			return
+		}
+		height = block.Height
+	}
+
+	blocks, err := s.db.Blocks.Siblings(height)
+	if shouldReturn(c, err) {
+		return
+	}
+	if len(blocks) == 0 {
+		notFound(c, store.ErrNotFound)
+		return
+	}
+
+	jsonOk(c, blocks)
+}
+
+// GetForks returns heights with competing blocks and their branch lengths
+func (s *Server) GetForks(c *gin.Context) {
+	params := forksParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	forks, err := s.db.Blocks.Forks(params.from, params.to, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, forks)
+}
+
 // GetBlocks returns a list of available blocks matching the filter
 func (s *Server) GetBlocks(c *gin.Context) {
 	search := &store.BlockSearch{}
@@ -245,6 +328,23 @@ func (s *Server) GetBlocks(c *gin.Context) {
 	jsonOk(c, blocks)
 }
 
+// GetOrphans returns orphaned blocks and the winning blocks at the same height
+func (s *Server) GetOrphans(c *gin.Context) {
+	params := orphansParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params.setDefaults()
+
+	orphans, err := s.db.Blocks.Orphans(params.Creator, params.MaxHeight, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, orphans)
+}
+
 // GetBlockTimes returns avg block times info
 func (s *Server) GetBlockTimes(c *gin.Context) {
 	params := blockTimesParams{}
@@ -323,6 +423,29 @@ func (s *Server) GetValidator(c *gin.Context) {
 		return
 	}
 
+	// Upcoming stake is only available once the next epoch ledger is imported
+	var next *ValidatorNextEpoch
+	nextLedger, err := s.db.Staking.LastLedgerByType(model.LedgerTypeNext)
+	if err != store.ErrNotFound && shouldReturn(c, err) {
+		return
+	}
+	if nextLedger != nil {
+		next = &ValidatorNextEpoch{Epoch: nextLedger.Epoch}
+
+		next.Stake, err = s.db.Staking.DelegateStake(nextLedger.ID, validator.PublicKey)
+		if shouldReturn(c, err) {
+			return
+		}
+
+		next.Delegations, err = s.db.Staking.FindDelegations(store.FindDelegationsParams{
+			LedgerID: &nextLedger.ID,
+			Delegate: validator.PublicKey,
+		})
+		if err != store.ErrNotFound && shouldReturn(c, err) {
+			return
+		}
+	}
+
 	stats30d, err := s.db.Stats.ValidatorStats(validator, 30, store.BucketDay)
 	if shouldReturn(c, err) {
 		return
@@ -337,6 +460,7 @@ func (s *Server) GetValidator(c *gin.Context) {
 		Validator:   validator,
 		Account:     account,
 		Delegations: delegations,
+		Next:        next,
 		Stats:       stats30d,
 		StatsHourly: stats24h,
 		StatsDaily:  stats30d,
@@ -360,12 +484,158 @@ func (s *Server) GetValidatorStats(c *gin.Context) {
 	jsonOk(c, stats)
 }
 
+// GetValidatorPerformance renders the validator expected vs actual block production
+func (s *Server) GetValidatorPerformance(c *gin.Context) {
+	tb := c.MustGet("timebucket").(timeBucket)
+
+	params := performanceParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	validator, err := s.db.Validators.FindByPublicKey(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	if params.Epoch == nil {
+		block, err := s.db.Blocks.Recent()
+		if shouldReturn(c, err) {
+			return
+		}
+		params.Epoch = &block.Epoch
+	}
+
+	performance, err := s.db.Validators.Performance(validator.PublicKey, *params.Epoch)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	stats, err := s.db.Stats.ValidatorStats(validator, tb.Period, tb.Interval)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, ValidatorPerformanceResponse{
+		Performance: performance,
+		Stats:       stats,
+	})
+}
+
+// GetValidatorRewards renders the validator rewards distribution for an epoch
+func (s *Server) GetValidatorRewards(c *gin.Context) {
+	params := rewardsParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	export := exportParams{}
+	if err := c.BindQuery(&export); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := export.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	validator, err := s.db.Validators.FindByPublicKey(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	// Use the most recent completed epoch by default
+	if params.Epoch == nil {
+		block, err := s.db.Blocks.Recent()
+		if shouldReturn(c, err) {
+			return
+		}
+		epoch := block.Epoch - 1
+		if epoch < 0 {
+			epoch = 0
+		}
+		params.Epoch = &epoch
+	}
+
+	// Payouts are only persisted by the rewards export command
+	summary, err := s.db.Rewards.CalculateWithStored(validator.PublicKey, *params.Epoch, params.Fee)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	if export.isStream() {
+		streamExport(c, export.Format, "rewards", rewardExportHeader, func(write func(exportRow) error) error {
+			for _, payout := range summary.Payouts {
+				if err := write(newRewardExportRow(payout)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		return
+	}
+
+	jsonOk(c, summary)
+}
+
+// GetValidatorDelegatorChanges renders delegators gained and lost by the validator per epoch
+func (s *Server) GetValidatorDelegatorChanges(c *gin.Context) {
+	input := &LedgerRequest{}
+	if err := c.BindQuery(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	validator, err := s.db.Validators.FindByPublicKey(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	epoch := -1
+	if input.Epoch != nil {
+		epoch = *input.Epoch
+	}
+
+	changes, err := s.db.Staking.DelegatorChanges(validator.PublicKey, epoch)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, changes)
+}
+
 // GetDelegations rendes all existing delegations
 func (s *Server) GetDelegations(c *gin.Context) {
-	delegations, err := s.db.Staking.FindDelegations(store.FindDelegationsParams{
+	input := &LedgerRequest{}
+	if err := c.BindQuery(input); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	params := store.FindDelegationsParams{
 		PublicKey: c.Query("public_key"),
 		Delegate:  c.Query("delegate"),
-	})
+	}
+
+	if input.Epoch != nil {
+		ledger, err := s.db.Staking.FindLedger(*input.Epoch)
+		if shouldReturn(c, err) {
+			return
+		}
+		params.LedgerID = &ledger.ID
+	}
+
+	delegations, err := s.db.Staking.FindDelegations(params)
 	if err != store.ErrNotFound && shouldReturn(c, err) {
 		return
 	}
@@ -398,6 +668,65 @@ func (s *Server) GetSnarker(c *gin.Context) {
 	jsonOk(c, result)
 }
 
+// GetSnarkerJobs returns a page of snark jobs completed by the snarker
+func (s *Server) GetSnarkerJobs(c *gin.Context) {
+	params := snarkerJobsParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params.setDefaults()
+
+	snarker, err := s.db.Snarkers.FindSnarker(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jobs, err := s.db.Jobs.ByProver(snarker.Account, params.Page, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, jobs)
+}
+
+// GetSnarkerEarnings returns snarker jobs and earnings for a given time bucket
+func (s *Server) GetSnarkerEarnings(c *gin.Context) {
+	tb := c.MustGet("timebucket").(timeBucket)
+
+	snarker, err := s.db.Snarkers.FindSnarker(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	stats, err := s.db.Stats.SnarkerStats(snarker, tb.Period, tb.Interval)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, stats)
+}
+
+// GetSnarksMarket returns the snark work fee distribution and backlog
+func (s *Server) GetSnarksMarket(c *gin.Context) {
+	tb := c.MustGet("timebucket").(timeBucket)
+
+	current, err := s.db.SnarkPool.Recent()
+	if shouldReturn(c, err) {
+		return
+	}
+
+	history, err := s.db.SnarkPool.History(tb.Period, tb.Interval)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, SnarksMarketResponse{
+		Current: current,
+		History: history,
+	})
+}
+
 // GetTransactions returns transactions by height
 func (s *Server) GetTransactions(c *gin.Context) {
 	search := store.TransactionSearch{}
@@ -406,11 +735,47 @@ func (s *Server) GetTransactions(c *gin.Context) {
 		return
 	}
 
+	s.renderTransactions(c, search)
+}
+
+// GetAccountTransactions returns transactions sent or received by the account
+func (s *Server) GetAccountTransactions(c *gin.Context) {
+	search := store.TransactionSearch{}
+	if err := c.BindQuery(&search); err != nil {
+		badRequest(c, err)
+		return
+	}
+	search.Account = c.Param("id")
+
+	s.renderTransactions(c, search)
+}
+
+// renderTransactions renders the matching transactions as JSON or streams them as an export
+func (s *Server) renderTransactions(c *gin.Context, search store.TransactionSearch) {
+	export := exportParams{}
+	if err := c.BindQuery(&export); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := export.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
 	if err := search.Validate(); err != nil {
 		badRequest(c, err)
 		return
 	}
 
+	if export.isStream() {
+		streamExport(c, export.Format, "transactions", transactionExportHeader, func(write func(exportRow) error) error {
+			return s.db.Transactions.Export(search, func(tx model.Transaction) error {
+				return write(newTransactionExportRow(tx))
+			})
+		})
+		return
+	}
+
 	transactions, err := s.db.Transactions.Search(search)
 	if shouldReturn(c, err) {
 		return
@@ -428,6 +793,98 @@ func (s *Server) GetPendingTransactions(c *gin.Context) {
 	jsonOk(c, transactions)
 }
 
+// GetFeesEstimate returns suggested transaction fees
+func (s *Server) GetFeesEstimate(c *gin.Context) {
+	params := feesEstimateParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params.setDefaults()
+
+	recent, err := s.db.Transactions.RecentFees(params.Blocks)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	// Pending transactions are optional, estimate from recent blocks if node is not available
+	pending := []types.Amount{}
+	transactions, err := s.graphClient.GetPendingTransactions()
+	if err != nil {
+		s.log.WithError(err).Warn("pending transactions fetch failed")
+	}
+	for _, tx := range transactions {
+		pending = append(pending, types.NewAmount(tx.Fee))
+	}
+
+	jsonOk(c, model.EstimateFees(recent, pending))
+}
+
+// GetAccounts returns a list of accounts matching the filter
+func (s *Server) GetAccounts(c *gin.Context) {
+	search := &store.AccountSearch{}
+
+	if err := c.BindQuery(search); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	if err := search.Validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	accounts, err := s.db.Accounts.Search(search)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, accounts)
+}
+
+// GetTopAccounts returns the largest account holders and their share of total currency
+func (s *Server) GetTopAccounts(c *gin.Context) {
+	params := topAccountsParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params.setDefaults()
+
+	block, err := s.db.Blocks.Recent()
+	if shouldReturn(c, err) {
+		return
+	}
+
+	token := model.DefaultToken
+
+	accounts, err := s.db.Accounts.Search(&store.AccountSearch{
+		Token: &token,
+		Sort:  "balance",
+		Order: "desc",
+		Page:  1,
+		Limit: params.Limit,
+	})
+	if shouldReturn(c, err) {
+		return
+	}
+
+	resp := TopAccountsResponse{
+		Height:        block.Height,
+		TotalCurrency: block.TotalCurrency,
+		Accounts:      make([]TopAccount, len(accounts)),
+	}
+
+	for idx, acc := range accounts {
+		resp.Accounts[idx] = TopAccount{Account: acc}
+		if acc.Balance.Int != nil && block.TotalCurrency.Int != nil {
+			resp.Accounts[idx].Share = acc.Balance.PercentOf(block.TotalCurrency)
+		}
+	}
+
+	jsonOk(c, resp)
+}
+
 // GetAccount returns account for by hash or ID
 func (s *Server) GetAccount(c *gin.Context) {
 	var (
@@ -435,6 +892,16 @@ func (s *Server) GetAccount(c *gin.Context) {
 		err error
 	)
 
+	params := accountsIndexParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
 	id := resourceID(c, "id")
 	if id.IsNumeric() {
 		acc, err = s.db.Accounts.FindByID(id.Int64())
@@ -445,9 +912,223 @@ func (s *Server) GetAccount(c *gin.Context) {
 		return
 	}
 
+	// Render the account state as of the requested height or time
+	if params.Height > 0 || params.time != nil {
+		var balance *model.AccountBalance
+
+		if params.Height > 0 {
+			balance, err = s.db.Accounts.BalanceAtHeight(acc.PublicKey, uint64(params.Height))
+		} else {
+			balance, err = s.db.Accounts.BalanceAtTime(acc.PublicKey, *params.time)
+		}
+		if shouldReturn(c, err) {
+			return
+		}
+
+		acc.Balance = balance.Balance
+		acc.BalanceUnknown = balance.BalanceUnknown
+		acc.Nonce = balance.Nonce
+		acc.LastHeight = balance.Height
+		acc.LastTime = balance.Time
+	}
+
 	jsonOk(c, acc)
 }
 
+// GetAccountVesting returns the account locked balance and unlock schedule
+func (s *Server) GetAccountVesting(c *gin.Context) {
+	params := vestingParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	ledger, err := s.db.Staking.LastLedger()
+	if shouldReturn(c, err) {
+		return
+	}
+
+	entry, err := s.db.Staking.FindLedgerEntry(ledger.ID, c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	slot, err := s.currentSlot(params.Slot)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	balance := entry.Balance
+	account, err := s.db.Accounts.FindByPublicKey(entry.PublicKey)
+	if err != store.ErrNotFound && shouldReturn(c, err) {
+		return
+	}
+	if err == nil {
+		balance = account.Balance
+	}
+
+	locked, liquid := entry.LockedBalanceAt(balance, slot)
+
+	resp := AccountVestingResponse{
+		PublicKey:         entry.PublicKey,
+		Epoch:             slot / model.SlotsPerEpoch,
+		Slot:              slot,
+		Balance:           balance,
+		Locked:            locked,
+		Liquid:            liquid,
+		InitialMinBalance: entry.TimingInitialMinimumBalance,
+		CliffTime:         entry.TimingCliffTime,
+		CliffAmount:       entry.TimingCliffAmount,
+		VestingPeriod:     entry.TimingVestingPeriod,
+		VestingIncrement:  entry.TimingVestingIncrement,
+		Schedule:          entry.VestingSchedule(),
+	}
+	if vestedSlot, ok := entry.FullyVestedSlot(); ok {
+		resp.FullyVestedSlot = &vestedSlot
+	}
+
+	jsonOk(c, resp)
+}
+
+// GetAccountDelegationHistory returns the epochs where the account delegate has changed
+func (s *Server) GetAccountDelegationHistory(c *gin.Context) {
+	history, err := s.db.Staking.DelegationHistory(c.Param("id"))
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, history)
+}
+
+// GetSupply returns the most recent currency supply
+func (s *Server) GetSupply(c *gin.Context) {
+	supply, err := s.db.Supply.Recent()
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, supply)
+}
+
+// GetSupplyHistory returns currency supply stats for a given time bucket
+func (s *Server) GetSupplyHistory(c *gin.Context) {
+	tb := c.MustGet("timebucket").(timeBucket)
+	result, err := s.db.Supply.History(tb.Period, tb.Interval)
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, result)
+}
+
+// GetSupplyVesting returns the network-wide unlock schedule per epoch
+func (s *Server) GetSupplyVesting(c *gin.Context) {
+	params := vestingParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	ledger, err := s.db.Staking.LastLedger()
+	if shouldReturn(c, err) {
+		return
+	}
+
+	entries, err := s.db.Staking.TimedLedgerRecords(ledger.ID)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	slot, err := s.currentSlot(params.Slot)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, SupplyVestingResponse{
+		LedgerEpoch: ledger.Epoch,
+		Epoch:       slot / model.SlotsPerEpoch,
+		Slot:        slot,
+		Locked:      model.LockedSupplyAt(entries, slot),
+		Schedule:    model.NetworkVestingSchedule(entries),
+	})
+}
+
+// currentSlot returns the requested slot or the slot of the most recent block
+func (s *Server) currentSlot(slot *int) (int, error) {
+	if slot != nil {
+		return *slot, nil
+	}
+
+	block, err := s.db.Blocks.Recent()
+	if err != nil {
+		return 0, err
+	}
+
+	return block.Slot, nil
+}
+
+// GetEpochs returns the most recent epoch summaries
+func (s *Server) GetEpochs(c *gin.Context) {
+	params := epochsParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params.setDefaults()
+
+	epochs, err := s.db.Epochs.All(int(params.Limit))
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, epochs)
+}
+
+// GetEpoch returns a single epoch summary
+func (s *Server) GetEpoch(c *gin.Context) {
+	epoch, ok := s.findEpoch(c)
+	if !ok {
+		return
+	}
+	jsonOk(c, epoch)
+}
+
+// GetEpochValidators returns the block production of validators in the epoch
+func (s *Server) GetEpochValidators(c *gin.Context) {
+	epoch, ok := s.findEpoch(c)
+	if !ok {
+		return
+	}
+
+	validators, err := s.db.Epochs.Validators(epoch.Epoch)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, EpochValidatorsResponse{
+		Epoch:      epoch,
+		Validators: validators,
+	})
+}
+
+func (s *Server) findEpoch(c *gin.Context) (*model.Epoch, bool) {
+	id := resourceID(c, "id")
+	if !id.IsNumeric() {
+		badRequest(c, errors.New("epoch number is invalid"))
+		return nil, false
+	}
+
+	epoch, err := s.db.Epochs.FindByEpoch(int(id.Int64()))
+	if shouldReturn(c, err) {
+		return nil, false
+	}
+	return epoch, true
+}
+
 // GetLedgers returns a list of all existing ledgers
 func (s *Server) GetLedgers(c *gin.Context) {
 	ledgers, err := s.db.Staking.AllLedgers()
@@ -457,6 +1138,36 @@ func (s *Server) GetLedgers(c *gin.Context) {
 	jsonOk(c, ledgers)
 }
 
+// GetLedgersDiff returns the changes between the staking ledgers of two epochs
+func (s *Server) GetLedgersDiff(c *gin.Context) {
+	params := ledgersDiffParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	from, err := s.db.Staking.FindLedger(*params.From)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	to, err := s.db.Staking.FindLedger(*params.To)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	diff, err := s.db.Staking.LedgerDiff(from, to, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+
+	jsonOk(c, diff)
+}
+
 // GetLedger records the current epoch ledger records
 func (s *Server) GetLedger(c *gin.Context) {
 	var (
@@ -469,11 +1180,15 @@ func (s *Server) GetLedger(c *gin.Context) {
 		badRequest(c, err)
 		return
 	}
+	if err := input.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
 
 	if epoch := input.Epoch; epoch != nil {
-		ledger, err = s.db.Staking.FindLedger(*epoch)
+		ledger, err = s.db.Staking.FindLedgerByType(*epoch, input.Type)
 	} else {
-		ledger, err = s.db.Staking.LastLedger()
+		ledger, err = s.db.Staking.LastLedgerByType(input.Type)
 	}
 	if shouldReturn(c, err) {
 		return
@@ -489,3 +1204,76 @@ func (s *Server) GetLedger(c *gin.Context) {
 		Records: records,
 	})
 }
+
+// GetTokens returns all tokens created on chain
+func (s *Server) GetTokens(c *gin.Context) {
+	tokens, err := s.db.Tokens.All()
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, tokens)
+}
+
+// GetToken returns a single token details
+func (s *Server) GetToken(c *gin.Context) {
+	id := resourceID(c, "id")
+	if !id.IsNumeric() {
+		badRequest(c, errors.New("token id is invalid"))
+		return
+	}
+
+	token, err := s.db.Tokens.FindByID(id.UInt64())
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, token)
+}
+
+// GetSearch returns blocks, transactions, validators and accounts matching the query
+func (s *Server) GetSearch(c *gin.Context) {
+	params := searchParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	results, err := s.db.Search.Search(params.Query, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, results)
+}
+
+// GetWebhookDeliveries returns the delivery log of a webhook subscription
+func (s *Server) GetWebhookDeliveries(c *gin.Context) {
+	id := resourceID(c, "id")
+	if !id.IsNumeric() {
+		badRequest(c, errors.New("webhook id is invalid"))
+		return
+	}
+
+	params := webhookDeliveriesParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	sub, err := s.db.Webhooks.FindSubscription(int(id.Int64()))
+	if shouldReturn(c, err) {
+		return
+	}
+
+	deliveries, err := s.db.Webhooks.Deliveries(sub.ID, params.Status, params.Page, params.Limit)
+	if shouldReturn(c, err) {
+		return
+	}
+	jsonOk(c, deliveries)
+}
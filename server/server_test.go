@@ -0,0 +1,33 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/config"
+)
+
+func TestAccountsRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := New(nil, config.New(), logrus.StandardLogger())
+
+	handlers := map[string]string{}
+	for _, route := range s.Routes() {
+		handlers[route.Method+" "+route.Path] = route.Handler
+	}
+
+	assert.True(t, strings.HasSuffix(handlers["GET /accounts/top"], ".GetTopAccounts-fm"))
+	assert.True(t, strings.HasSuffix(handlers["GET /accounts/:id"], ".GetAccount-fm"))
+
+	// Invalid limit is only rejected by the top accounts handler
+	req := httptest.NewRequest(http.MethodGet, "/accounts/top?limit=invalid", nil)
+	resp := httptest.NewRecorder()
+	s.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
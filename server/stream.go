@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store"
+)
+
+const (
+	streamClientBuffer = 100
+	streamKeepAlive    = 30 * time.Second
+)
+
+// streamHub fans out the worker events to the connected stream clients
+type streamHub struct {
+	connStr string
+	log     *logrus.Logger
+	once    sync.Once
+
+	mu      sync.Mutex
+	clients map[chan model.StreamEvent]struct{}
+}
+
+func newStreamHub(connStr string, logger *logrus.Logger) *streamHub {
+	return &streamHub{
+		connStr: connStr,
+		log:     logger,
+		clients: map[chan model.StreamEvent]struct{}{},
+	}
+}
+
+// subscribe registers a new client, the events listener is started with the first client
+func (h *streamHub) subscribe() chan model.StreamEvent {
+	h.once.Do(func() {
+		go h.listen()
+	})
+
+	ch := make(chan model.StreamEvent, streamClientBuffer)
+
+	h.mu.Lock()
+	h.clients[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch
+}
+
+func (h *streamHub) unsubscribe(ch chan model.StreamEvent) {
+	h.mu.Lock()
+	delete(h.clients, ch)
+	h.mu.Unlock()
+}
+
+// broadcast sends the event to all clients, slow clients miss the event
+func (h *streamHub) broadcast(event model.StreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.clients {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func (h *streamHub) listen() {
+	for {
+		listener, err := store.NewEventsListener(h.connStr)
+		if err == nil {
+			err = listener.Run(context.Background(), h.broadcast)
+		}
+		if err != nil {
+			h.log.WithError(err).Error("stream listener failed")
+		}
+		time.Sleep(10 * time.Second)
+	}
+}
+
+// GetStream streams the indexed blocks and transactions as server-sent events
+func (s *Server) GetStream(c *gin.Context) {
+	params := streamParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	events := s.stream.subscribe()
+	defer s.stream.unsubscribe(events)
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-keepAlive.C:
+			_, err := fmt.Fprint(w, ": keepalive\n\n")
+			return err == nil
+		case event := <-events:
+			if event.Matches(params.Account, params.types) {
+				c.SSEvent(event.Type, event)
+			}
+			return true
+		}
+	})
+}
+
+// GetWebsocket streams the indexed blocks and transactions over a websocket connection
+func (s *Server) GetWebsocket(c *gin.Context) {
+	params := streamParams{}
+	if err := c.BindQuery(&params); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := params.validate(); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	conn, err := upgradeWebsocket(c)
+	if err != nil {
+		// The upgrader renders the handshake errors itself
+		if !c.Writer.Written() {
+			badRequest(c, err)
+		}
+		return
+	}
+	defer conn.Close()
+
+	events := s.stream.subscribe()
+	defer s.stream.unsubscribe(events)
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-conn.closed:
+			return
+		case <-keepAlive.C:
+			if err := conn.WritePing(); err != nil {
+				return
+			}
+		case event := <-events:
+			if !event.Matches(params.Account, params.types) {
+				continue
+			}
+			if err := conn.WriteJSON(event); err != nil {
+				return
+			}
+		}
+	}
+}
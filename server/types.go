@@ -1,9 +1,11 @@
 package server
 
 import (
+	"errors"
 	"time"
 
 	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
 )
 
 type HealthResponse struct {
@@ -35,17 +37,86 @@ type BlockResponse struct {
 	SnarkJobs    []model.SnarkJob    `json:"snark_jobs"`
 }
 
+type EpochValidatorsResponse struct {
+	Epoch      *model.Epoch           `json:"epoch"`
+	Validators []model.EpochValidator `json:"validators"`
+}
+
+type SnarksMarketResponse struct {
+	Current *model.SnarkPool      `json:"current"`
+	History []model.SnarkPoolStat `json:"history"`
+}
+
+type ValidatorPerformanceResponse struct {
+	Performance *model.ValidatorPerformance `json:"performance"`
+	Stats       []model.ValidatorStat       `json:"stats"`
+}
+
 type ValidatorResponse struct {
 	Validator   *model.Validator      `json:"validator"`
 	Account     *model.Account        `json:"account"`
 	Delegations []model.Delegation    `json:"delegations"`
+	Next        *ValidatorNextEpoch   `json:"next"`
 	Stats       []model.ValidatorStat `json:"stats"`
 	StatsHourly []model.ValidatorStat `json:"stats_hourly"`
 	StatsDaily  []model.ValidatorStat `json:"stats_daily"`
 }
 
+type ValidatorNextEpoch struct {
+	Epoch       int                `json:"epoch"`
+	Stake       types.Amount       `json:"stake"`
+	Delegations []model.Delegation `json:"delegations"`
+}
+
+type TopAccount struct {
+	model.Account
+	Share float64 `json:"share"`
+}
+
+type TopAccountsResponse struct {
+	Height        uint64       `json:"height"`
+	TotalCurrency types.Amount `json:"total_currency"`
+	Accounts      []TopAccount `json:"accounts"`
+}
+
+type AccountVestingResponse struct {
+	PublicKey         string                `json:"public_key"`
+	Epoch             int                   `json:"epoch"`
+	Slot              int                   `json:"slot"`
+	Balance           types.Amount          `json:"balance"`
+	Locked            types.Amount          `json:"locked"`
+	Liquid            types.Amount          `json:"liquid"`
+	FullyVestedSlot   *int                  `json:"fully_vested_slot"`
+	InitialMinBalance types.Amount          `json:"initial_minimum_balance"`
+	CliffTime         *int                  `json:"cliff_time"`
+	CliffAmount       types.Amount          `json:"cliff_amount"`
+	VestingPeriod     *int                  `json:"vesting_period"`
+	VestingIncrement  types.Amount          `json:"vesting_increment"`
+	Schedule          []model.VestingUnlock `json:"schedule"`
+}
+
+type SupplyVestingResponse struct {
+	LedgerEpoch int                   `json:"ledger_epoch"`
+	Epoch       int                   `json:"epoch"`
+	Slot        int                   `json:"slot"`
+	Locked      types.Amount          `json:"locked"`
+	Schedule    []model.VestingUnlock `json:"schedule"`
+}
+
 type LedgerRequest struct {
-	Epoch *int `form:"epoch"`
+	Epoch *int   `form:"epoch"`
+	Type  string `form:"type"`
+}
+
+func (r *LedgerRequest) validate() error {
+	switch r.Type {
+	case "":
+		r.Type = model.LedgerTypeCurrent
+	case model.LedgerTypeCurrent, model.LedgerTypeNext:
+	default:
+		return errors.New("invalid ledger type")
+	}
+	return nil
 }
 
 type LedgerResponse struct {
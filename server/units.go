@@ -0,0 +1,162 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+const (
+	unitsNanomina = "nanomina"
+	unitsMina     = "mina"
+)
+
+var (
+	amountType    = reflect.TypeOf(types.Amount{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// unitsMiddleware validates the requested amount units
+func unitsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		units := c.Query("units")
+
+		switch units {
+		case "":
+			units = unitsNanomina
+		case unitsNanomina, unitsMina:
+		default:
+			badRequest(c, errors.New("invalid units: "+units))
+			return
+		}
+
+		c.Set("units", units)
+	}
+}
+
+// minaUnits returns a JSON compatible copy of the data with all amounts formatted in MINA
+func minaUnits(data interface{}) interface{} {
+	return convertAmounts(reflect.ValueOf(data))
+}
+
+func convertAmounts(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type() == amountType {
+		amount := v.Interface().(types.Amount)
+		if amount.Int == nil {
+			return nil
+		}
+		return amount.MINA()
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return convertAmounts(v.Elem())
+	}
+
+	// Types with custom serialization, ie. time or raw json, are rendered as is
+	if v.Type().Implements(marshalerType) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		result := map[string]interface{}{}
+		convertStruct(v, result)
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		fallthrough
+	case reflect.Array:
+		result := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result[i] = convertAmounts(v.Index(i))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		result := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, _ := json.Marshal(iter.Key().Interface())
+			result[strings.Trim(string(key), `"`)] = convertAmounts(iter.Value())
+		}
+		return result
+	default:
+		return v.Interface()
+	}
+}
+
+// convertStruct copies the struct fields into the map following the json tags
+func convertStruct(v reflect.Value, result map[string]interface{}) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		opts := strings.Split(tag, ",")
+		name := opts[0]
+		value := v.Field(i)
+
+		// Embedded structs without a name are flattened into the parent
+		if field.Anonymous && name == "" {
+			if value.Kind() == reflect.Ptr {
+				if value.IsNil() {
+					continue
+				}
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct {
+				convertStruct(value, result)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		if hasOption(opts[1:], "omitempty") && value.IsZero() {
+			continue
+		}
+
+		result[name] = convertAmounts(value)
+	}
+}
+
+func hasOption(opts []string, name string) bool {
+	for _, opt := range opts {
+		if opt == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsWriteTimeout   = 10 * time.Second
+	wsPongTimeout    = streamKeepAlive + wsWriteTimeout
+	wsMaxMessageSize = 512
+)
+
+// Cross origin browser connections are rejected, same as the default upgrader policy
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// wsConn is a websocket connection only used to push stream events.
+// Client messages other than control frames are ignored.
+type wsConn struct {
+	conn   *websocket.Conn
+	closed chan struct{}
+	once   sync.Once
+}
+
+// upgradeWebsocket performs the websocket handshake and takes over the connection
+func upgradeWebsocket(c *gin.Context) (*wsConn, error) {
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ws := &wsConn{
+		conn:   conn,
+		closed: make(chan struct{}),
+	}
+	go ws.readLoop()
+
+	return ws, nil
+}
+
+// WriteJSON sends the value as a text message
+func (ws *wsConn) WriteJSON(v interface{}) error {
+	ws.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return ws.conn.WriteJSON(v)
+}
+
+// WritePing sends a ping control frame, the client must respond before the pong timeout
+func (ws *wsConn) WritePing() error {
+	return ws.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+}
+
+// Close performs the close handshake and closes the underlying connection
+func (ws *wsConn) Close() error {
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	ws.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteTimeout))
+	ws.shutdown()
+	return ws.conn.Close()
+}
+
+func (ws *wsConn) shutdown() {
+	ws.once.Do(func() {
+		close(ws.closed)
+	})
+}
+
+// readLoop handles the client control frames until the connection is closed or the client stops responding
+func (ws *wsConn) readLoop() {
+	defer ws.shutdown()
+
+	ws.conn.SetReadLimit(wsMaxMessageSize)
+	ws.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	ws.conn.SetPongHandler(func(string) error {
+		return ws.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		if _, _, err := ws.conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
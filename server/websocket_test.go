@@ -0,0 +1,52 @@
+package server
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsocket(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/ws", func(c *gin.Context) {
+		conn, err := upgradeWebsocket(c)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(gin.H{"type": "block"})
+		<-conn.closed
+	})
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	// Plain requests are rejected by the upgrader
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest("GET", "/ws", nil))
+	assert.Equal(t, 400, resp.Code)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer client.Close()
+
+	event := map[string]string{}
+	assert.NoError(t, client.ReadJSON(&event))
+	assert.Equal(t, "block", event["type"])
+
+	// Server completes the close handshake started by the client
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	assert.NoError(t, client.WriteMessage(websocket.CloseMessage, msg))
+
+	_, _, err = client.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+}
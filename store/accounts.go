@@ -1,9 +1,11 @@
 package store
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
+	"github.com/jinzhu/gorm"
 
 	"github.com/figment-networks/mina-indexer/model"
 	"github.com/figment-networks/mina-indexer/store/queries"
@@ -32,16 +34,28 @@ func (s AccountsStore) FindByID(id int64) (*model.Account, error) {
 	return s.FindBy("id", id)
 }
 
-// FindByPublicKey returns an account for the public key
+// FindByPublicKey returns the MINA account for the public key
 func (s AccountsStore) FindByPublicKey(key string) (*model.Account, error) {
-	return s.FindBy("public_key", key)
+	return s.FindByPublicKeyAndToken(key, model.DefaultToken)
 }
 
-// AllByDelegator returns all accounts delegated to another account
+// FindByPublicKeyAndToken returns an account for the public key and token
+func (s AccountsStore) FindByPublicKeyAndToken(key string, token uint64) (*model.Account, error) {
+	result := &model.Account{}
+
+	err := s.db.
+		Where("public_key = ? AND token = ?", key, token).
+		Take(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// AllByDelegator returns all MINA accounts delegated to another account
 func (s AccountsStore) AllByDelegator(account string) ([]model.Account, error) {
 	result := []model.Account{}
 	err := s.db.
-		Where("delegate = ?", account).
+		Where("delegate = ? AND token = ?", account, model.DefaultToken).
 		Find(&result).
 		Error
 	return result, checkErr(err)
@@ -72,11 +86,73 @@ func (s AccountsStore) All() ([]model.Account, error) {
 	return result, checkErr(err)
 }
 
+// BalanceAtHeight returns the most recent account balance snapshot at or below the height
+func (s AccountsStore) BalanceAtHeight(key string, height uint64) (*model.AccountBalance, error) {
+	result := &model.AccountBalance{}
+
+	err := s.db.
+		Where("public_key = ? AND height <= ?", key, height).
+		Order("height DESC").
+		Take(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// BalanceAtTime returns the most recent account balance snapshot at or before the time
+func (s AccountsStore) BalanceAtTime(key string, t time.Time) (*model.AccountBalance, error) {
+	result := &model.AccountBalance{}
+
+	err := s.db.
+		Where("public_key = ? AND time <= ?", key, t).
+		Order("time DESC, height DESC").
+		Take(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// Search returns accounts that match search filters
+func (s AccountsStore) Search(search *AccountSearch) ([]model.Account, error) {
+	result := []model.Account{}
+
+	scope := s.db.
+		Order(fmt.Sprintf("%s %s NULLS LAST, id ASC", search.Sort, search.Order)).
+		Offset((search.Page - 1) * search.Limit).
+		Limit(search.Limit)
+
+	if search.Delegate != "" {
+		scope = scope.Where("delegate = ?", search.Delegate)
+	}
+	if search.Token != nil {
+		scope = scope.Where("token = ?", *search.Token)
+	}
+	if search.MinBalance != "" {
+		scope = scope.Where("balance >= ?", search.MinBalance)
+	}
+	if search.MaxBalance != "" {
+		scope = scope.Where("balance <= ?", search.MaxBalance)
+	}
+
+	err := scope.Find(&result).Error
+	return result, checkErr(err)
+}
+
 func (s AccountsStore) UpdateStaking() error {
 	return s.db.Exec(queries.AccountsUpdateStaking).Error
 }
 
 func (s AccountsStore) Import(records []model.Account) error {
+	return importAccounts(s.db, queries.AccountsImport, records)
+}
+
+// ImportStaged creates or updates the accounts from the staged ledger.
+// Staged ledger entries have no nonce, so the stored nonce is kept.
+func (s AccountsStore) ImportStaged(records []model.Account) error {
+	return importAccounts(s.db, queries.AccountsImportStaged, records)
+}
+
+func importAccounts(db *gorm.DB, query string, records []model.Account) error {
 	n := len(records)
 	if n == 0 {
 		return nil
@@ -92,12 +168,13 @@ func (s AccountsStore) Import(records []model.Account) error {
 
 		batch := records[idx:endIdx]
 
-		err := bulk.Import(s.db, queries.AccountsImport, len(batch), func(rowIdx int) bulk.Row {
+		err := bulk.Import(db, query, len(batch), func(rowIdx int) bulk.Row {
 			acc := batch[rowIdx]
 			now := time.Now()
 
 			return bulk.Row{
 				acc.PublicKey,
+				acc.Token,
 				acc.Delegate,
 				acc.Balance,
 				acc.BalanceUnknown,
@@ -118,3 +195,100 @@ func (s AccountsStore) Import(records []model.Account) error {
 
 	return nil
 }
+
+// ImportBalances creates or updates account balance snapshots in bulk
+func (s AccountsStore) ImportBalances(records []model.AccountBalance) error {
+	n := len(records)
+	if n == 0 {
+		return nil
+	}
+
+	batchSize := 250
+	now := time.Now()
+
+	for idx := 0; idx < n; idx += batchSize {
+		endIdx := idx + batchSize
+		if endIdx > n {
+			endIdx = n
+		}
+
+		batch := records[idx:endIdx]
+
+		err := bulk.Import(s.db, queries.AccountBalancesImport, len(batch), func(rowIdx int) bulk.Row {
+			r := batch[rowIdx]
+
+			return bulk.Row{
+				r.PublicKey,
+				r.Height,
+				r.Time,
+				r.Balance,
+				r.BalanceUnknown,
+				r.Nonce,
+				now,
+			}
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportStagedBalances creates balance snapshots of the staged ledger accounts.
+// A snapshot is only created when the balance or delegate differs from the latest known state,
+// so it must run before the staged accounts are imported.
+func (s AccountsStore) ImportStagedBalances(records []model.Account) error {
+	balances := make([]model.Account, 0, len(records))
+	for _, acc := range records {
+		if acc.Token == model.DefaultToken {
+			balances = append(balances, acc)
+		}
+	}
+
+	for idx := 0; idx < len(balances); idx += batchSize {
+		endIdx := idx + batchSize
+		if endIdx > len(balances) {
+			endIdx = len(balances)
+		}
+
+		batch := balances[idx:endIdx]
+
+		err := bulk.Import(s.db, queries.AccountBalancesImportStaged, len(batch), func(rowIdx int) bulk.Row {
+			acc := batch[rowIdx]
+
+			return bulk.Row{
+				acc.PublicKey,
+				acc.LastHeight,
+				acc.LastTime,
+				acc.Balance,
+				acc.Delegate,
+			}
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateCreations records the creation fees and start heights of existing accounts created by
+// canonical transactions since the given height, along with their initial balances
+func (s AccountsStore) UpdateCreations(height uint64) error {
+	if err := s.db.Exec(queries.AccountsUpdateCreations, height).Error; err != nil {
+		return err
+	}
+	return s.db.Exec(queries.AccountBalancesImportCreations, height, model.DefaultToken).Error
+}
+
+// RevertCreations removes the creation fees recorded by orphaned transactions at a height,
+// along with the balance snapshots only created for them
+func (s AccountsStore) RevertCreations(height uint64) error {
+	if err := s.db.Exec(queries.AccountsRevertCreations, height).Error; err != nil {
+		return err
+	}
+	return s.db.Exec(queries.AccountBalancesRevertCreations, height, model.DefaultToken).Error
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"errors"
+	"regexp"
+)
+
+var (
+	reAmount = regexp.MustCompile(`^[\d]+$`)
+)
+
+// AccountSearch contains account search params
+type AccountSearch struct {
+	Delegate   string  `form:"delegate"`
+	Token      *uint64 `form:"token"`
+	MinBalance string  `form:"min_balance"`
+	MaxBalance string  `form:"max_balance"`
+	Sort       string  `form:"sort"`
+	Order      string  `form:"order"`
+	Page       uint    `form:"page"`
+	Limit      uint    `form:"limit"`
+}
+
+// Validate performs validation on search parameters
+func (search *AccountSearch) Validate() error {
+	switch search.Sort {
+	case "balance", "stake", "nonce":
+	case "":
+		search.Sort = "balance"
+	default:
+		return errors.New("invalid sort field")
+	}
+
+	switch search.Order {
+	case "":
+		search.Order = "desc"
+	case "asc", "desc":
+	default:
+		return errors.New("invalid sort order")
+	}
+
+	if search.MinBalance != "" && !reAmount.MatchString(search.MinBalance) {
+		return errors.New("invalid min balance")
+	}
+	if search.MaxBalance != "" && !reAmount.MatchString(search.MaxBalance) {
+		return errors.New("invalid max balance")
+	}
+
+	if search.Page == 0 {
+		search.Page = 1
+	}
+
+	if search.Limit == 0 {
+		search.Limit = 100
+	}
+	if search.Limit > 100 {
+		return errors.New("max limit is 100")
+	}
+
+	return nil
+}
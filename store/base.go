@@ -3,12 +3,15 @@ package store
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jinzhu/gorm"
 )
 
 var (
 	ErrNotFound = errors.New("record not found")
+
+	likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 )
 
 // baseStore implements generic store operations
@@ -61,3 +64,8 @@ func checkErr(err error) error {
 	}
 	return err
 }
+
+// escapeLike escapes the LIKE pattern wildcards in the input
+func escapeLike(input string) string {
+	return likeEscaper.Replace(input)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/store/jsonquery"
 	"github.com/figment-networks/mina-indexer/model"
@@ -74,17 +75,55 @@ func (s BlocksStore) Search(search *BlockSearch) ([]model.Block, error) {
 		scope = scope.Where("creator = ?", search.Creator)
 	}
 
+	if search.Canonical != nil {
+		scope = scope.Where("canonical = ?", *search.Canonical)
+	}
+
 	return result, scope.Find(&result).Error
 }
 
+// Siblings returns all blocks indexed at the given height
+func (s BlocksStore) Siblings(height uint64) ([]model.Block, error) {
+	result := []model.Block{}
+
+	err := s.db.
+		Where("height = ?", height).
+		Order("canonical DESC, hash ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// Forks returns heights with competing blocks within the time range
+func (s BlocksStore) Forks(start, end time.Time, limit uint) ([]model.Fork, error) {
+	branches := []model.ForkBranch{}
+
+	err := s.db.Raw(queries.BlocksForks, start, end, limit, model.MaxForkLength).Scan(&branches).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	return model.GroupForks(branches), nil
+}
+
+// Orphans returns non-canonical blocks along with the canonical blocks at the same height
+func (s BlocksStore) Orphans(creator string, maxHeight uint64, limit uint) ([]model.OrphanBlock, error) {
+	result := []model.OrphanBlock{}
+	err := s.db.Raw(queries.BlocksOrphans, creator, maxHeight, limit).Scan(&result).Error
+	return result, checkErr(err)
+}
+
 // AvgTimes returns recent blocks averages
 func (s BlocksStore) AvgTimes(limit int64) ([]byte, error) {
 	return jsonquery.MustObject(s.db, queries.BlocksTimes, limit)
 }
 
 // Stats returns block stats for a given interval
-func (s BlocksStore) Stats(period uint, interval string) ([]byte, error) {
-	return jsonquery.MustArray(s.db, queries.BlocksStats, period, interval)
+func (s BlocksStore) Stats(period uint, interval string) ([]model.ChainStat, error) {
+	result := []model.ChainStat{}
+	err := s.db.Raw(queries.BlocksStats, period, interval).Scan(&result).Error
+	return result, checkErr(err)
 }
 
 // MarkBlocksOrphan updates all blocks as non canonical at a height
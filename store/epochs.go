@@ -0,0 +1,37 @@
+package store
+
+import (
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// EpochsStore handles operations on epochs
+type EpochsStore struct {
+	baseStore
+}
+
+// FindByEpoch returns an epoch record with the matching number
+func (s EpochsStore) FindByEpoch(epoch int) (*model.Epoch, error) {
+	result := &model.Epoch{}
+	err := findBy(s.db, result, "epoch", epoch)
+	return result, checkErr(err)
+}
+
+// All returns all epoch records, most recent first
+func (s EpochsStore) All(limit int) ([]model.Epoch, error) {
+	result := []model.Epoch{}
+	err := s.db.Order("epoch DESC").Limit(limit).Find(&result).Error
+	return result, checkErr(err)
+}
+
+// Validators returns the block production of all validators in the epoch
+func (s EpochsStore) Validators(epoch int) ([]model.EpochValidator, error) {
+	result := []model.EpochValidator{}
+	err := s.db.Raw(queries.EpochValidators, epoch).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// Update creates or refreshes the epoch summary from the canonical blocks
+func (s EpochsStore) Update(epoch int, stakingLedgerHash string) error {
+	return s.db.Exec(queries.EpochsImport, epoch, model.SlotsPerEpoch, stakingLedgerHash).Error
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/model"
+)
+
+// EventsChannel is the postgres notification channel used for stream events
+const EventsChannel = "mina_indexer_events"
+
+// EventsStore publishes stream events to the listening processes
+type EventsStore struct {
+	baseStore
+}
+
+// Publish sends the events via postgres notifications
+func (s EventsStore) Publish(events ...model.StreamEvent) error {
+	for _, event := range events {
+		data, err := event.Encode()
+		if err != nil {
+			return err
+		}
+		if err := s.db.Exec("SELECT pg_notify(?, ?)", EventsChannel, string(data)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EventsListener receives stream events published by the worker
+type EventsListener struct {
+	listener *pq.Listener
+}
+
+// NewEventsListener returns a new listener for the connection string
+func NewEventsListener(connStr string) (*EventsListener, error) {
+	listener := pq.NewListener(connStr, 10*time.Second, time.Minute, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			log.WithError(err).Error("events listener error")
+		}
+	})
+
+	if err := listener.Listen(EventsChannel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return &EventsListener{listener: listener}, nil
+}
+
+// Run passes the received events to the callback until the context is cancelled
+func (l *EventsListener) Run(ctx context.Context, fn func(model.StreamEvent)) error {
+	defer l.listener.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-l.listener.Notify:
+			// Nil notification is sent after the connection is reestablished
+			if n == nil {
+				continue
+			}
+
+			event := model.StreamEvent{}
+			if err := json.Unmarshal([]byte(n.Extra), &event); err != nil {
+				log.WithError(err).Error("events listener decode failed")
+				continue
+			}
+			fn(event)
+		case <-time.After(90 * time.Second):
+			go l.listener.Ping()
+		}
+	}
+}
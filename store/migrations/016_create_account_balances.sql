@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS account_balances (
+  id              SERIAL NOT NULL,
+  public_key      TEXT NOT NULL,
+  height          CHAIN_HEIGHT,
+  time            CHAIN_TIME,
+  balance         CHAIN_CURRENCY,
+  balance_unknown CHAIN_CURRENCY,
+  nonce           INTEGER NOT NULL DEFAULT 0,
+  created_at      CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_account_balances_public_key_height
+  ON account_balances(public_key, height);
+
+CREATE INDEX idx_account_balances_public_key_time
+  ON account_balances(public_key, time);
+
+-- +goose Down
+DROP TABLE account_balances;
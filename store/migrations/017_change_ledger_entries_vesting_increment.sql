@@ -0,0 +1,10 @@
+-- +goose Up
+-- Vesting increment is an amount, previously stored as whole MINA in an integer column
+ALTER TABLE ledger_entries
+  ALTER COLUMN timing_vesting_increment TYPE CHAIN_CURRENCY
+  USING timing_vesting_increment * 1000000000;
+
+-- +goose Down
+ALTER TABLE ledger_entries
+  ALTER COLUMN timing_vesting_increment TYPE INTEGER
+  USING timing_vesting_increment / 1000000000;
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS supply (
+  id                 SERIAL NOT NULL,
+  height             CHAIN_HEIGHT,
+  block_hash         TEXT NOT NULL,
+  time               CHAIN_TIME,
+  epoch              INTEGER DEFAULT 0,
+  slot               INTEGER DEFAULT 0,
+  total_supply       CHAIN_CURRENCY DEFAULT 0,
+  locked_supply      CHAIN_CURRENCY DEFAULT 0,
+  circulating_supply CHAIN_CURRENCY DEFAULT 0,
+  created_at         CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_supply_height
+  ON supply(height);
+
+CREATE INDEX idx_supply_block_hash
+  ON supply(block_hash);
+
+CREATE INDEX idx_supply_time
+  ON supply(time);
+
+ALTER TABLE chain_stats ADD COLUMN locked_supply CHAIN_CURRENCY DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN circulating_supply CHAIN_CURRENCY DEFAULT 0;
+
+-- +goose Down
+DROP TABLE supply;
+
+ALTER TABLE chain_stats DROP COLUMN locked_supply;
+ALTER TABLE chain_stats DROP COLUMN circulating_supply;
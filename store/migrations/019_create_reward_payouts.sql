@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS reward_payouts (
+  id            SERIAL NOT NULL,
+  validator     TEXT NOT NULL,
+  epoch         INTEGER NOT NULL,
+  delegator     TEXT NOT NULL,
+  balance       CHAIN_CURRENCY,
+  share         NUMERIC NOT NULL DEFAULT 0,
+  reward        CHAIN_CURRENCY,
+  validator_fee NUMERIC NOT NULL DEFAULT 0,
+  created_at    CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_reward_payouts_validator_epoch_delegator
+  ON reward_payouts(validator, epoch, delegator);
+
+CREATE INDEX idx_reward_payouts_delegator
+  ON reward_payouts(delegator);
+
+-- +goose Down
+DROP TABLE reward_payouts;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS epochs (
+  id                  SERIAL NOT NULL,
+  epoch               INTEGER NOT NULL,
+  start_slot          INTEGER NOT NULL DEFAULT 0,
+  end_slot            INTEGER NOT NULL DEFAULT 0,
+  start_height        CHAIN_HEIGHT,
+  end_height          CHAIN_HEIGHT,
+  start_time          CHAIN_TIME,
+  end_time            CHAIN_TIME,
+  blocks_count        INTEGER NOT NULL DEFAULT 0,
+  slots_filled        INTEGER NOT NULL DEFAULT 0,
+  slots_available     INTEGER NOT NULL DEFAULT 0,
+  slot_fill_rate      NUMERIC(5, 2) NOT NULL DEFAULT 0,
+  producers_count     INTEGER NOT NULL DEFAULT 0,
+  coinbase            CHAIN_CURRENCY DEFAULT 0,
+  fees                CHAIN_CURRENCY DEFAULT 0,
+  snark_fees          CHAIN_CURRENCY DEFAULT 0,
+  staking_ledger_hash TEXT,
+  created_at          CHAIN_TIME,
+  updated_at          CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_epochs_epoch
+  ON epochs(epoch);
+
+CREATE INDEX idx_blocks_epoch
+  ON blocks(epoch);
+
+-- +goose Down
+DROP TABLE epochs;
+
+DROP INDEX IF EXISTS idx_blocks_epoch;
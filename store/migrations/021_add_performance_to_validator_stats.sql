@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE validator_stats ADD COLUMN expected_blocks_count NUMERIC DEFAULT 0;
+ALTER TABLE validator_stats ADD COLUMN performance_score NUMERIC DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE validator_stats DROP COLUMN expected_blocks_count;
+ALTER TABLE validator_stats DROP COLUMN performance_score;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE validators ADD COLUMN blocks_orphaned INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE validator_stats ADD COLUMN blocks_orphaned_count INTEGER DEFAULT 0;
+
+UPDATE validators
+SET blocks_orphaned = orphans.total
+FROM (
+  SELECT creator, COUNT(1) AS total
+  FROM blocks
+  WHERE
+    canonical = false
+    AND EXISTS (SELECT 1 FROM blocks winners WHERE winners.height = blocks.height AND winners.canonical = true)
+  GROUP BY creator
+) orphans
+WHERE validators.public_key = orphans.creator;
+
+-- +goose Down
+ALTER TABLE validators DROP COLUMN blocks_orphaned;
+ALTER TABLE validator_stats DROP COLUMN blocks_orphaned_count;
@@ -0,0 +1,6 @@
+-- +goose Up
+CREATE INDEX idx_blocks_parent_hash
+  ON blocks (parent_hash);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_blocks_parent_hash;
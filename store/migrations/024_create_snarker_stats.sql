@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE snarker_stats (
+  id              CHAIN_UUID,
+  snarker_id      INTEGER NOT NULL,
+  time            CHAIN_TIME,
+  bucket          CHAIN_INTERVAL,
+  jobs_count      INTEGER DEFAULT 0,
+  works_count     INTEGER DEFAULT 0,
+  fees_amount     CHAIN_CURRENCY DEFAULT 0,
+  earnings_count  INTEGER DEFAULT 0,
+  earnings_amount CHAIN_CURRENCY DEFAULT 0
+);
+
+CREATE UNIQUE INDEX idx_snarker_stats_bucket
+  ON snarker_stats(time, bucket, snarker_id);
+
+-- +goose Down
+DROP TABLE snarker_stats;
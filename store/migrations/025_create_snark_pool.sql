@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS snark_pool (
+  id                    SERIAL NOT NULL,
+  time                  CHAIN_TIME,
+  pending_works_count   INTEGER NOT NULL DEFAULT 0,
+  completed_works_count INTEGER NOT NULL DEFAULT 0,
+  provers_count         INTEGER NOT NULL DEFAULT 0,
+  fee_min               CHAIN_CURRENCY DEFAULT 0,
+  fee_median            CHAIN_CURRENCY DEFAULT 0,
+  fee_max               CHAIN_CURRENCY DEFAULT 0,
+  created_at            CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_snark_pool_time
+  ON snark_pool(time);
+
+-- +goose Down
+DROP TABLE snark_pool;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE chain_stats ADD COLUMN fees_count INTEGER DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN fee_min CHAIN_CURRENCY DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN fee_p25 CHAIN_CURRENCY DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN fee_median CHAIN_CURRENCY DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN fee_p75 CHAIN_CURRENCY DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN fee_max CHAIN_CURRENCY DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE chain_stats DROP COLUMN fees_count;
+ALTER TABLE chain_stats DROP COLUMN fee_min;
+ALTER TABLE chain_stats DROP COLUMN fee_p25;
+ALTER TABLE chain_stats DROP COLUMN fee_median;
+ALTER TABLE chain_stats DROP COLUMN fee_p75;
+ALTER TABLE chain_stats DROP COLUMN fee_max;
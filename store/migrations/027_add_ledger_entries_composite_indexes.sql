@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE INDEX idx_ledger_entries_ledger_public_key
+  ON ledger_entries(ledger_id, public_key);
+
+CREATE INDEX idx_ledger_entries_ledger_delegate
+  ON ledger_entries(ledger_id, delegate);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_ledger_entries_ledger_public_key;
+DROP INDEX IF EXISTS idx_ledger_entries_ledger_delegate;
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE ledgers ADD COLUMN type TEXT NOT NULL DEFAULT 'current';
+
+DROP INDEX IF EXISTS idx_ledgers_epoch;
+
+CREATE UNIQUE INDEX idx_ledgers_epoch_type
+  ON ledgers(epoch, type);
+
+-- +goose Down
+DELETE FROM ledger_entries WHERE ledger_id IN (SELECT id FROM ledgers WHERE type <> 'current');
+DELETE FROM ledgers WHERE type <> 'current';
+
+DROP INDEX IF EXISTS idx_ledgers_epoch_type;
+
+ALTER TABLE ledgers DROP COLUMN type;
+
+CREATE UNIQUE INDEX idx_ledgers_epoch
+  ON ledgers(epoch);
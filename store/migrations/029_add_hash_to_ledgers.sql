@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE ledgers ADD COLUMN hash TEXT;
+ALTER TABLE ledgers ADD COLUMN total_currency CHAIN_CURRENCY;
+
+-- +goose Down
+ALTER TABLE ledgers DROP COLUMN hash;
+ALTER TABLE ledgers DROP COLUMN total_currency;
@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE tokens (
+  id           BIGINT NOT NULL,
+  owner        TEXT,
+  tx_hash      TEXT,
+  height       CHAIN_HEIGHT,
+  time         CHAIN_TIME,
+  created_at   CHAIN_TIME,
+  updated_at   CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_tokens_owner
+  ON tokens(owner);
+
+ALTER TABLE transactions ADD COLUMN token BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE transactions ADD COLUMN fee_token BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE transactions ADD COLUMN created_token BIGINT;
+
+CREATE INDEX idx_transactions_token
+  ON transactions(token);
+
+ALTER TABLE accounts ADD COLUMN token BIGINT NOT NULL DEFAULT 1;
+
+DROP INDEX IF EXISTS idx_accounts_public_key;
+
+CREATE UNIQUE INDEX idx_accounts_public_key_token
+  ON accounts(public_key, token);
+
+-- +goose Down
+DELETE FROM accounts WHERE token <> 1;
+
+DROP INDEX IF EXISTS idx_accounts_public_key_token;
+
+ALTER TABLE accounts DROP COLUMN token;
+
+CREATE UNIQUE INDEX idx_accounts_public_key
+  ON accounts(public_key);
+
+ALTER TABLE transactions DROP COLUMN token;
+ALTER TABLE transactions DROP COLUMN fee_token;
+ALTER TABLE transactions DROP COLUMN created_token;
+
+DROP TABLE tokens;
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN fee_payer_account_creation_fee CHAIN_CURRENCY;
+ALTER TABLE transactions ADD COLUMN receiver_account_creation_fee CHAIN_CURRENCY;
+
+ALTER TABLE blocks ADD COLUMN new_accounts_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE accounts ADD COLUMN creation_fee CHAIN_CURRENCY;
+
+ALTER TABLE account_balances ADD COLUMN creation_fee CHAIN_CURRENCY;
+
+ALTER TABLE chain_stats ADD COLUMN new_accounts_count INTEGER DEFAULT 0;
+ALTER TABLE chain_stats ADD COLUMN account_creation_fees CHAIN_CURRENCY DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN fee_payer_account_creation_fee;
+ALTER TABLE transactions DROP COLUMN receiver_account_creation_fee;
+ALTER TABLE blocks DROP COLUMN new_accounts_count;
+ALTER TABLE accounts DROP COLUMN creation_fee;
+ALTER TABLE account_balances DROP COLUMN creation_fee;
+ALTER TABLE chain_stats DROP COLUMN new_accounts_count;
+ALTER TABLE chain_stats DROP COLUMN account_creation_fees;
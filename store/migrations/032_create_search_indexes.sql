@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_validators_identity_name_trgm
+  ON validators USING GIN (identity_name gin_trgm_ops);
+
+CREATE INDEX idx_validators_public_key_prefix
+  ON validators(public_key text_pattern_ops);
+
+CREATE INDEX idx_accounts_public_key_prefix
+  ON accounts(public_key text_pattern_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_accounts_public_key_prefix;
+DROP INDEX IF EXISTS idx_validators_public_key_prefix;
+DROP INDEX IF EXISTS idx_validators_identity_name_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE transactions ADD COLUMN memo_raw TEXT;
+
+CREATE INDEX idx_transactions_memo_trgm
+  ON transactions USING GIN (memo gin_trgm_ops);
+
+CREATE INDEX idx_transactions_memo_fulltext
+  ON transactions USING GIN (TO_TSVECTOR('simple', COALESCE(memo, '')));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_memo_fulltext;
+DROP INDEX IF EXISTS idx_transactions_memo_trgm;
+
+ALTER TABLE transactions DROP COLUMN memo_raw;
@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE webhook_subscriptions (
+  id          SERIAL NOT NULL,
+  url         TEXT NOT NULL,
+  secret      TEXT NOT NULL,
+  events      TEXT[],
+  account     TEXT,
+  validator   TEXT,
+  tx_type     TEXT,
+  min_amount  CHAIN_CURRENCY,
+  active      BOOLEAN NOT NULL DEFAULT true,
+  created_at  CHAIN_TIME,
+  updated_at  CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_webhook_subscriptions_account
+  ON webhook_subscriptions(account);
+
+CREATE INDEX idx_webhook_subscriptions_validator
+  ON webhook_subscriptions(validator);
+
+CREATE TABLE webhook_deliveries (
+  id              SERIAL NOT NULL,
+  subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+  event           TEXT NOT NULL,
+  event_key       TEXT NOT NULL,
+  payload         JSONB NOT NULL,
+  status          TEXT NOT NULL DEFAULT 'pending',
+  attempts        INTEGER NOT NULL DEFAULT 0,
+  response_code   INTEGER,
+  error           TEXT,
+  next_attempt_at CHAIN_TIME,
+  delivered_at    TIMESTAMP WITH TIME ZONE,
+  created_at      CHAIN_TIME,
+  updated_at      CHAIN_TIME,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX idx_webhook_deliveries_event
+  ON webhook_deliveries(subscription_id, event, event_key);
+
+CREATE INDEX idx_webhook_deliveries_pending
+  ON webhook_deliveries(next_attempt_at)
+  WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
@@ -0,0 +1,16 @@
+-- +goose Up
+DROP INDEX IF EXISTS idx_reward_payouts_validator_epoch_delegator;
+
+CREATE UNIQUE INDEX idx_reward_payouts_validator_epoch_fee_delegator
+  ON reward_payouts(validator, epoch, validator_fee, delegator);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_reward_payouts_validator_epoch_fee_delegator;
+
+DELETE FROM reward_payouts
+WHERE id NOT IN (
+  SELECT MAX(id) FROM reward_payouts GROUP BY validator, epoch, delegator
+);
+
+CREATE UNIQUE INDEX idx_reward_payouts_validator_epoch_delegator
+  ON reward_payouts(validator, epoch, delegator);
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE ledgers ADD COLUMN verified BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE ledgers ADD COLUMN verify_error TEXT;
+ALTER TABLE ledgers ADD COLUMN verified_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE ledgers DROP COLUMN verified;
+ALTER TABLE ledgers DROP COLUMN verify_error;
+ALTER TABLE ledgers DROP COLUMN verified_at;
@@ -0,0 +1,6 @@
+-- +goose Up
+CREATE INDEX idx_transactions_memo_lower
+  ON transactions(LOWER(memo));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_transactions_memo_lower;
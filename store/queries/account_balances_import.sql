@@ -0,0 +1,16 @@
+INSERT INTO account_balances (
+  public_key,
+  height,
+  time,
+  balance,
+  balance_unknown,
+  nonce,
+  created_at
+)
+VALUES @values
+ON CONFLICT (public_key, height) DO UPDATE
+SET
+  time            = excluded.time,
+  balance         = excluded.balance,
+  balance_unknown = excluded.balance_unknown,
+  nonce           = excluded.nonce
\ No newline at end of file
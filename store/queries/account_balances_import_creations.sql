@@ -0,0 +1,33 @@
+INSERT INTO account_balances (
+  public_key,
+  height,
+  time,
+  balance,
+  balance_unknown,
+  nonce,
+  creation_fee,
+  created_at
+)
+SELECT DISTINCT ON (receiver)
+  receiver,
+  block_height,
+  time,
+  amount - receiver_account_creation_fee,
+  amount - receiver_account_creation_fee,
+  0,
+  receiver_account_creation_fee,
+  NOW()
+FROM transactions
+WHERE
+  block_height >= $1
+  AND canonical = true
+  AND type = 'payment'
+  AND token = $2
+  AND receiver_account_creation_fee IS NOT NULL
+  AND amount >= receiver_account_creation_fee
+ORDER BY receiver, block_height, id
+ON CONFLICT (public_key, height) DO UPDATE
+SET
+  creation_fee = excluded.creation_fee
+WHERE
+  account_balances.creation_fee IS NULL
\ No newline at end of file
@@ -0,0 +1,36 @@
+INSERT INTO account_balances (
+  public_key,
+  height,
+  time,
+  balance,
+  balance_unknown,
+  nonce,
+  created_at
+)
+SELECT
+  staged.public_key,
+  staged.height::INTEGER,
+  staged.time::TIMESTAMP WITH TIME ZONE,
+  staged.balance::DECIMAL(65, 0),
+  staged.balance::DECIMAL(65, 0),
+  COALESCE(latest.nonce, 0),
+  NOW()
+FROM (VALUES @values) AS staged (public_key, height, time, balance, delegate)
+LEFT JOIN accounts
+  ON accounts.public_key = staged.public_key
+  AND accounts.token = 1
+LEFT JOIN LATERAL (
+  SELECT balance, nonce
+  FROM account_balances
+  WHERE account_balances.public_key = staged.public_key
+  ORDER BY height DESC
+  LIMIT 1
+) latest ON true
+WHERE
+  latest.balance IS DISTINCT FROM staged.balance::DECIMAL(65, 0)
+  OR accounts.delegate IS DISTINCT FROM staged.delegate
+ON CONFLICT (public_key, height) DO UPDATE
+SET
+  time            = excluded.time,
+  balance         = excluded.balance,
+  balance_unknown = excluded.balance_unknown
\ No newline at end of file
@@ -0,0 +1,22 @@
+WITH synthetic AS (
+  DELETE FROM account_balances
+  USING transactions
+  WHERE
+    account_balances.height = $1
+    AND account_balances.nonce = 0
+    AND transactions.block_height = $1
+    AND transactions.canonical = false
+    AND transactions.type = 'payment'
+    AND transactions.token = $2
+    AND transactions.receiver = account_balances.public_key
+    AND transactions.receiver_account_creation_fee = account_balances.creation_fee
+    AND transactions.amount - transactions.receiver_account_creation_fee = account_balances.balance
+  RETURNING account_balances.id
+)
+UPDATE account_balances
+SET
+  creation_fee = NULL
+WHERE
+  height = $1
+  AND creation_fee IS NOT NULL
+  AND id NOT IN (SELECT id FROM synthetic)
\ No newline at end of file
@@ -0,0 +1,23 @@
+SELECT
+  epoch,
+  delegate,
+  previous_delegate,
+  balance
+FROM (
+  SELECT
+    ledgers.epoch,
+    ledger_entries.delegate,
+    LAG(ledger_entries.delegate) OVER (ORDER BY ledgers.epoch) AS previous_delegate,
+    ledger_entries.balance
+  FROM
+    ledger_entries
+  INNER JOIN ledgers
+    ON ledgers.id = ledger_entries.ledger_id
+  WHERE
+    ledger_entries.public_key = $1
+    AND ledgers.type = 'current'
+) history
+WHERE
+  previous_delegate IS DISTINCT FROM delegate
+ORDER BY
+  epoch DESC
\ No newline at end of file
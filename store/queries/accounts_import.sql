@@ -1,5 +1,6 @@
 INSERT INTO accounts (
   public_key,
+  token,
   delegate,
   balance,
   balance_unknown,
@@ -12,7 +13,7 @@ INSERT INTO accounts (
   updated_at
 )
 VALUES @values
-ON CONFLICT (public_key) DO UPDATE
+ON CONFLICT (public_key, token) DO UPDATE
 SET
   delegate        = excluded.delegate,
   balance         = excluded.balance,
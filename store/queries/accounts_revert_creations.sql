@@ -0,0 +1,20 @@
+UPDATE accounts
+SET
+  creation_fee = NULL,
+  updated_at   = NOW()
+FROM transactions
+WHERE
+  transactions.block_height = $1
+  AND transactions.canonical = false
+  AND (
+    (
+      transactions.receiver = accounts.public_key
+      AND transactions.token = accounts.token
+      AND transactions.receiver_account_creation_fee IS NOT NULL
+    )
+    OR (
+      transactions.sender = accounts.public_key
+      AND transactions.fee_token = accounts.token
+      AND transactions.fee_payer_account_creation_fee IS NOT NULL
+    )
+  )
\ No newline at end of file
@@ -0,0 +1,29 @@
+UPDATE accounts
+SET
+  creation_fee = creations.creation_fee,
+  start_height = LEAST(accounts.start_height, creations.block_height),
+  start_time   = CASE
+    WHEN creations.block_height < accounts.start_height THEN creations.time
+    ELSE accounts.start_time
+  END,
+  updated_at   = NOW()
+FROM (
+  SELECT receiver AS public_key, token, receiver_account_creation_fee AS creation_fee, block_height, time
+  FROM transactions
+  WHERE
+    block_height >= $1
+    AND canonical = true
+    AND receiver_account_creation_fee IS NOT NULL
+  UNION
+  SELECT sender AS public_key, fee_token AS token, fee_payer_account_creation_fee AS creation_fee, block_height, time
+  FROM transactions
+  WHERE
+    block_height >= $1
+    AND canonical = true
+    AND sender IS NOT NULL
+    AND fee_payer_account_creation_fee IS NOT NULL
+) creations
+WHERE
+  accounts.public_key = creations.public_key
+  AND accounts.token = creations.token
+  AND accounts.creation_fee IS NULL
\ No newline at end of file
@@ -1,10 +1,11 @@
 WITH staking AS (
   SELECT delegate, SUM(balance) AS total, COUNT(1) AS delegations
   FROM ledger_entries
-  WHERE ledger_id = (SELECT id FROM ledgers ORDER BY id DESC LIMIT 1)
+  WHERE ledger_id = (SELECT id FROM ledgers WHERE type = 'current' ORDER BY id DESC LIMIT 1)
   GROUP BY delegate
 )
 UPDATE accounts
 SET stake = staking.total
 FROM staking
-WHERE accounts.public_key = staking.delegate
\ No newline at end of file
+WHERE accounts.public_key = staking.delegate
+  AND accounts.token = 1
\ No newline at end of file
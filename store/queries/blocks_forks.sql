@@ -0,0 +1,49 @@
+WITH RECURSIVE fork_heights AS (
+  SELECT height
+  FROM blocks
+  WHERE time >= $1 AND time <= $2
+  GROUP BY height
+  HAVING COUNT(1) > 1
+  ORDER BY height DESC
+  LIMIT $3
+),
+fork_blocks AS (
+  SELECT * FROM blocks
+  WHERE height IN (SELECT height FROM fork_heights)
+),
+branches AS (
+  SELECT hash AS root, hash, 1 AS depth
+  FROM fork_blocks
+
+  UNION ALL
+
+  SELECT branches.root, blocks.hash, branches.depth + 1
+  FROM branches
+  INNER JOIN blocks
+    ON blocks.parent_hash = branches.hash
+  WHERE
+    branches.depth < $4
+)
+SELECT
+  fork_blocks.height,
+  fork_blocks.hash,
+  fork_blocks.parent_hash,
+  fork_blocks.creator,
+  fork_blocks.time,
+  fork_blocks.canonical,
+  MAX(branches.depth) AS length
+FROM
+  fork_blocks
+INNER JOIN branches
+  ON branches.root = fork_blocks.hash
+GROUP BY
+  fork_blocks.height,
+  fork_blocks.hash,
+  fork_blocks.parent_hash,
+  fork_blocks.creator,
+  fork_blocks.time,
+  fork_blocks.canonical
+ORDER BY
+  fork_blocks.height DESC,
+  fork_blocks.canonical DESC,
+  fork_blocks.hash ASC
\ No newline at end of file
@@ -0,0 +1,21 @@
+SELECT
+  orphans.height,
+  orphans.hash,
+  orphans.creator,
+  orphans.time,
+  winners.hash AS winner_hash,
+  winners.creator AS winner_creator,
+  winners.time AS winner_time
+FROM
+  blocks orphans
+INNER JOIN blocks winners
+  ON winners.height = orphans.height
+  AND winners.canonical = true
+WHERE
+  orphans.canonical = false
+  AND ($1 = '' OR orphans.creator = $1)
+  AND ($2 = 0 OR orphans.height <= $2)
+ORDER BY
+  orphans.height DESC,
+  orphans.hash ASC
+LIMIT $3
\ No newline at end of file
@@ -17,7 +17,17 @@ SELECT
   total_currency::TEXT total_currency,
   staked_amount::TEXT staked_amount,
   delegations_count,
-  delegations_amount::TEXT delegations_amount
+  delegations_amount::TEXT delegations_amount,
+  locked_supply::TEXT locked_supply,
+  circulating_supply::TEXT circulating_supply,
+  fees_count,
+  fee_min::TEXT fee_min,
+  fee_p25::TEXT fee_p25,
+  fee_median::TEXT fee_median,
+  fee_p75::TEXT fee_p75,
+  fee_max::TEXT fee_max,
+  new_accounts_count,
+  account_creation_fees::TEXT account_creation_fees
 FROM
   chain_stats
 WHERE
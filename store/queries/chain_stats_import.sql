@@ -2,9 +2,21 @@ WITH current_ledger AS (
   SELECT * FROM ledger_entries
   WHERE ledger_id = (
     SELECT id FROM ledgers
-    WHERE epoch = (SELECT MAX(epoch) FROM blocks WHERE time >= $1 AND time <= $2)
+    WHERE epoch = (SELECT MAX(epoch) FROM blocks WHERE time >= $1 AND time <= $2) AND type = 'current'
     LIMIT 1
   )
+),
+user_fees AS (
+  SELECT transactions.fee
+  FROM transactions
+  INNER JOIN blocks
+    ON blocks.hash = transactions.block_hash
+  WHERE
+    blocks.time >= $1
+    AND blocks.time <= $2
+    AND blocks.canonical = true
+    AND transactions.canonical = true
+    AND transactions.type IN ('payment', 'delegation')
 )
 INSERT INTO chain_stats (
   time,
@@ -31,7 +43,17 @@ INSERT INTO chain_stats (
   coinbase_amount,
   staked_amount,
   delegations_count,
-  delegations_amount
+  delegations_amount,
+  locked_supply,
+  circulating_supply,
+  fees_count,
+  fee_min,
+  fee_p25,
+  fee_median,
+  fee_p75,
+  fee_max,
+  new_accounts_count,
+  account_creation_fees
 )
 SELECT
   DATE_TRUNC('@bucket', blocks.time),
@@ -40,7 +62,7 @@ SELECT
   COUNT(DISTINCT blocks.height),
   (SELECT COUNT(1) FROM blocks),
   COUNT(DISTINCT(creator)),
-  (SELECT COUNT(1) FROM accounts),
+  (SELECT COUNT(1) FROM accounts WHERE token = 1),
   COUNT(DISTINCT(blocks.epoch)),
   COUNT(DISTINCT(blocks.slot)),
   (SELECT COUNT(1) FROM snarkers),
@@ -49,16 +71,27 @@ SELECT
   COALESCE(AVG(blocks.coinbase), 0),
   COALESCE(AVG(blocks.total_currency), 0),
   COUNT(transactions),
-  COALESCE(SUM(transactions.amount), 0),
+  COALESCE(SUM(transactions.amount) FILTER (WHERE transactions.token = 1), 0),
   COUNT(transactions) FILTER (WHERE type = 'payment'),
-  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'payment'), 0),
+  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'payment' AND transactions.token = 1), 0),
   COUNT(transactions) FILTER (WHERE type = 'fee_transfer'),
-  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'fee_transfer'), 0),
+  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'fee_transfer' AND transactions.token = 1), 0),
   COUNT(transactions) FILTER (WHERE type = 'coinbase'),
-  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'coinbase'), 0),
+  COALESCE(SUM(transactions.amount) FILTER (WHERE type = 'coinbase' AND transactions.token = 1), 0),
   COALESCE((SELECT SUM(balance) FROM current_ledger), 0),
   COALESCE((SELECT COUNT(1) FROM current_ledger WHERE delegation IS TRUE), 0),
-  COALESCE((SELECT SUM(balance) FROM current_ledger WHERE delegation IS TRUE), 0)
+  COALESCE((SELECT SUM(balance) FROM current_ledger WHERE delegation IS TRUE), 0),
+  COALESCE((SELECT AVG(locked_supply) FROM supply WHERE time >= $1 AND time <= $2), 0),
+  COALESCE((SELECT AVG(circulating_supply) FROM supply WHERE time >= $1 AND time <= $2), 0),
+  (SELECT COUNT(1) FROM user_fees),
+  COALESCE((SELECT MIN(fee) FROM user_fees), 0),
+  COALESCE((SELECT PERCENTILE_DISC(0.25) WITHIN GROUP (ORDER BY fee) FROM user_fees), 0),
+  COALESCE((SELECT PERCENTILE_DISC(0.5) WITHIN GROUP (ORDER BY fee) FROM user_fees), 0),
+  COALESCE((SELECT PERCENTILE_DISC(0.75) WITHIN GROUP (ORDER BY fee) FROM user_fees), 0),
+  COALESCE((SELECT MAX(fee) FROM user_fees), 0),
+  COUNT(transactions) FILTER (WHERE transactions.receiver_account_creation_fee IS NOT NULL)
+    + COUNT(transactions) FILTER (WHERE transactions.fee_payer_account_creation_fee IS NOT NULL),
+  COALESCE(SUM(COALESCE(transactions.receiver_account_creation_fee, 0) + COALESCE(transactions.fee_payer_account_creation_fee, 0)), 0)
 FROM
   blocks
 LEFT JOIN transactions
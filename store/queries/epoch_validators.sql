@@ -0,0 +1,19 @@
+SELECT
+  creator AS public_key,
+  COUNT(1) AS blocks_count,
+  COUNT(DISTINCT slot) AS slots_count,
+  MIN(height) AS first_height,
+  MAX(height) AS last_height,
+  COALESCE(SUM(coinbase), 0) AS coinbase,
+  COALESCE(SUM(snark_jobs_fees), 0) AS snark_fees,
+  ROUND(COUNT(1)::NUMERIC * 100 / SUM(COUNT(1)) OVER (), 2) AS blocks_share
+FROM
+  blocks
+WHERE
+  epoch = $1
+  AND canonical = TRUE
+GROUP BY
+  creator
+ORDER BY
+  blocks_count DESC,
+  creator ASC
\ No newline at end of file
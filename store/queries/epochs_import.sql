@@ -0,0 +1,78 @@
+WITH epoch_blocks AS (
+  SELECT * FROM blocks
+  WHERE epoch = $1 AND canonical = TRUE
+),
+epoch_fees AS (
+  SELECT COALESCE(SUM(fee), 0) AS amount
+  FROM transactions
+  WHERE
+    block_hash IN (SELECT hash FROM epoch_blocks)
+    AND canonical = TRUE
+    AND type IN ('payment', 'delegation')
+)
+INSERT INTO epochs (
+  epoch,
+  start_slot,
+  end_slot,
+  start_height,
+  end_height,
+  start_time,
+  end_time,
+  blocks_count,
+  slots_filled,
+  slots_available,
+  slot_fill_rate,
+  producers_count,
+  coinbase,
+  fees,
+  snark_fees,
+  staking_ledger_hash,
+  created_at,
+  updated_at
+)
+SELECT
+  $1,
+  $1 * $2,
+  ($1 + 1) * $2 - 1,
+  MIN(height),
+  MAX(height),
+  MIN(time),
+  MAX(time),
+  COUNT(1),
+  COUNT(DISTINCT slot),
+  slots.available,
+  ROUND(COUNT(DISTINCT slot)::NUMERIC * 100 / GREATEST(slots.available, 1), 2),
+  COUNT(DISTINCT creator),
+  COALESCE(SUM(coinbase), 0),
+  (SELECT amount FROM epoch_fees),
+  COALESCE(SUM(snark_jobs_fees), 0),
+  NULLIF($3, ''),
+  NOW(),
+  NOW()
+FROM
+  epoch_blocks,
+  LATERAL (
+    SELECT
+      CASE WHEN EXISTS (SELECT 1 FROM blocks WHERE epoch > $1)
+      THEN $2
+      ELSE (SELECT MAX(slot) FROM epoch_blocks) - $1 * $2 + 1
+      END AS available
+  ) slots
+GROUP BY
+  slots.available
+ON CONFLICT (epoch) DO UPDATE
+SET
+  start_height        = excluded.start_height,
+  end_height          = excluded.end_height,
+  start_time          = excluded.start_time,
+  end_time            = excluded.end_time,
+  blocks_count        = excluded.blocks_count,
+  slots_filled        = excluded.slots_filled,
+  slots_available     = excluded.slots_available,
+  slot_fill_rate      = excluded.slot_fill_rate,
+  producers_count     = excluded.producers_count,
+  coinbase            = excluded.coinbase,
+  fees                = excluded.fees,
+  snark_fees          = excluded.snark_fees,
+  staking_ledger_hash = COALESCE(excluded.staking_ledger_hash, epochs.staking_ledger_hash),
+  updated_at          = excluded.updated_at
\ No newline at end of file
@@ -0,0 +1,25 @@
+SELECT
+  COALESCE(current.public_key, previous.public_key) AS public_key,
+  CASE
+    WHEN previous.id IS NULL THEN 'added'
+    WHEN current.id IS NULL THEN 'removed'
+    ELSE 'changed'
+  END AS change,
+  previous.balance AS previous_balance,
+  current.balance AS balance,
+  COALESCE(current.balance, 0) - COALESCE(previous.balance, 0) AS balance_change,
+  previous.delegate AS previous_delegate,
+  current.delegate AS delegate
+FROM
+  (SELECT * FROM ledger_entries WHERE ledger_id = $1) previous
+FULL OUTER JOIN (SELECT * FROM ledger_entries WHERE ledger_id = $2) current
+  ON current.public_key = previous.public_key
+WHERE
+  previous.id IS NULL
+  OR current.id IS NULL
+  OR current.balance <> previous.balance
+  OR current.delegate <> previous.delegate
+ORDER BY
+  ABS(COALESCE(current.balance, 0) - COALESCE(previous.balance, 0)) DESC,
+  public_key ASC
+LIMIT $3
\ No newline at end of file
@@ -0,0 +1,10 @@
+SELECT
+  COUNT(1) FILTER (WHERE previous.id IS NULL) AS added_count,
+  COUNT(1) FILTER (WHERE current.id IS NULL) AS removed_count,
+  COUNT(1) FILTER (WHERE current.balance <> previous.balance) AS balance_changes_count,
+  COUNT(1) FILTER (WHERE current.delegate <> previous.delegate) AS delegate_changes_count,
+  COALESCE(SUM(current.balance), 0) - COALESCE(SUM(previous.balance), 0) AS balance_change
+FROM
+  (SELECT * FROM ledger_entries WHERE ledger_id = $1) previous
+FULL OUTER JOIN (SELECT * FROM ledger_entries WHERE ledger_id = $2) current
+  ON current.public_key = previous.public_key
\ No newline at end of file
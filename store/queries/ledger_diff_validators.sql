@@ -0,0 +1,27 @@
+SELECT
+  COALESCE(current.delegate, previous.delegate) AS public_key,
+  COALESCE(previous.stake, 0) AS previous_stake,
+  COALESCE(current.stake, 0) AS stake,
+  COALESCE(current.stake, 0) - COALESCE(previous.stake, 0) AS stake_change,
+  COALESCE(previous.delegations, 0) AS previous_delegations,
+  COALESCE(current.delegations, 0) AS delegations
+FROM (
+  SELECT delegate, SUM(balance) AS stake, COUNT(1) FILTER (WHERE delegation IS TRUE) AS delegations
+  FROM ledger_entries
+  WHERE ledger_id = $1
+  GROUP BY delegate
+) previous
+FULL OUTER JOIN (
+  SELECT delegate, SUM(balance) AS stake, COUNT(1) FILTER (WHERE delegation IS TRUE) AS delegations
+  FROM ledger_entries
+  WHERE ledger_id = $2
+  GROUP BY delegate
+) current
+  ON current.delegate = previous.delegate
+WHERE
+  COALESCE(current.stake, 0) <> COALESCE(previous.stake, 0)
+  OR COALESCE(current.delegations, 0) <> COALESCE(previous.delegations, 0)
+ORDER BY
+  ABS(COALESCE(current.stake, 0) - COALESCE(previous.stake, 0)) DESC,
+  public_key ASC
+LIMIT $3
\ No newline at end of file
@@ -0,0 +1,12 @@
+INSERT INTO reward_payouts (
+  validator,
+  epoch,
+  delegator,
+  balance,
+  share,
+  reward,
+  validator_fee,
+  created_at
+)
+VALUES @values
+ON CONFLICT (validator, epoch, validator_fee, delegator) DO NOTHING
\ No newline at end of file
@@ -0,0 +1,49 @@
+WITH matches AS (
+  SELECT 'block' AS type, hash AS id, height::TEXT AS label, 1.0::FLOAT AS rank
+  FROM blocks
+  WHERE hash = $1
+
+  UNION ALL
+
+  SELECT 'block', hash, height::TEXT, (CASE WHEN canonical THEN 1.0 ELSE 0.5 END)::FLOAT
+  FROM blocks
+  WHERE height = $2
+
+  UNION ALL
+
+  SELECT 'transaction', hash, type::TEXT, 1.0::FLOAT
+  FROM transactions
+  WHERE hash = $1
+
+  UNION ALL
+
+  SELECT 'validator', public_key, identity_name, LENGTH($1)::FLOAT / LENGTH(public_key)
+  FROM validators
+  WHERE public_key = $1 OR ($3 <> '' AND public_key LIKE $3)
+
+  UNION ALL
+
+  SELECT 'validator', public_key, identity_name, SIMILARITY(identity_name, $1)::FLOAT
+  FROM validators
+  WHERE identity_name % $1
+
+  UNION ALL
+
+  SELECT 'account', public_key, NULL::TEXT, LENGTH($1)::FLOAT / LENGTH(public_key)
+  FROM accounts
+  WHERE token = 1 AND (public_key = $1 OR ($3 <> '' AND public_key LIKE $3))
+)
+SELECT
+  type,
+  id,
+  MAX(label) AS label,
+  MAX(rank) AS rank
+FROM
+  matches
+GROUP BY
+  type, id
+ORDER BY
+  rank DESC,
+  type ASC,
+  id ASC
+LIMIT $4
\ No newline at end of file
@@ -0,0 +1,16 @@
+SELECT
+  DATE_TRUNC($2, time) AS time,
+  MIN(fee_min)::TEXT AS fee_min,
+  PERCENTILE_DISC(0.5) WITHIN GROUP (ORDER BY fee_median)::TEXT AS fee_median,
+  MAX(fee_max)::TEXT AS fee_max,
+  MAX(provers_count) AS provers_count,
+  ROUND(AVG(pending_works_count)) AS pending_works_count,
+  ROUND(AVG(completed_works_count)) AS completed_works_count
+FROM
+  snark_pool
+GROUP BY
+  DATE_TRUNC($2, time)
+ORDER BY
+  DATE_TRUNC($2, time) DESC
+LIMIT
+  $1
\ No newline at end of file
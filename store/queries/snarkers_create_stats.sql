@@ -0,0 +1,50 @@
+WITH canonical_blocks AS (
+  SELECT hash, creator FROM blocks
+  WHERE time >= $1 AND time <= $2 AND canonical = true
+),
+jobs AS (
+  SELECT * FROM snark_jobs
+  WHERE
+    prover = $3
+    AND block_hash IN (SELECT hash FROM canonical_blocks)
+),
+earnings AS (
+  SELECT transactions.amount
+  FROM transactions
+  INNER JOIN canonical_blocks
+    ON canonical_blocks.hash = transactions.block_hash
+  WHERE
+    transactions.receiver = $3
+    AND transactions.canonical = true
+    AND (
+      transactions.type IN ('snark_fee', 'fee_transfer_via_coinbase')
+      OR (transactions.type = 'fee_transfer' AND transactions.receiver <> canonical_blocks.creator)
+    )
+)
+INSERT INTO snarker_stats (
+  time,
+  bucket,
+  snarker_id,
+  jobs_count,
+  works_count,
+  fees_amount,
+  earnings_count,
+  earnings_amount
+)
+VALUES (
+  DATE_TRUNC('@bucket', $1::timestamp),
+  '@bucket',
+  (SELECT id FROM snarkers WHERE account = $3 LIMIT 1),
+  (SELECT COUNT(1) FROM jobs),
+  (SELECT COALESCE(SUM(works_count), 0) FROM jobs),
+  (SELECT COALESCE(SUM(fee), 0) FROM jobs),
+  (SELECT COUNT(1) FROM earnings),
+  (SELECT COALESCE(SUM(amount), 0) FROM earnings)
+)
+ON CONFLICT (time, bucket, snarker_id) DO UPDATE
+SET
+  jobs_count      = excluded.jobs_count,
+  works_count     = excluded.works_count,
+  fees_amount     = excluded.fees_amount,
+  earnings_count  = excluded.earnings_count,
+  earnings_amount = excluded.earnings_amount
\ No newline at end of file
@@ -0,0 +1,13 @@
+SELECT
+  time,
+  total_currency::TEXT total_supply,
+  locked_supply::TEXT locked_supply,
+  circulating_supply::TEXT circulating_supply
+FROM
+  chain_stats
+WHERE
+  bucket = $2
+ORDER BY
+  time DESC
+LIMIT
+  $1
\ No newline at end of file
@@ -0,0 +1,21 @@
+INSERT INTO supply (
+  height,
+  block_hash,
+  time,
+  epoch,
+  slot,
+  total_supply,
+  locked_supply,
+  circulating_supply,
+  created_at
+)
+VALUES @values
+ON CONFLICT (height) DO UPDATE
+SET
+  block_hash         = excluded.block_hash,
+  time               = excluded.time,
+  epoch              = excluded.epoch,
+  slot               = excluded.slot,
+  total_supply       = excluded.total_supply,
+  locked_supply      = excluded.locked_supply,
+  circulating_supply = excluded.circulating_supply
\ No newline at end of file
@@ -0,0 +1,17 @@
+SELECT *
+FROM blocks
+WHERE
+  canonical = TRUE
+  AND total_currency > 0
+  AND NOT EXISTS (
+    SELECT 1 FROM supply
+    WHERE supply.block_hash = blocks.hash
+  )
+  AND EXISTS (
+    SELECT 1 FROM ledgers
+    WHERE ledgers.epoch = blocks.epoch AND ledgers.type = 'current'
+  )
+ORDER BY
+  height DESC
+LIMIT
+  $1
\ No newline at end of file
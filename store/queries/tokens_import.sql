@@ -0,0 +1,17 @@
+INSERT INTO tokens (
+  id,
+  owner,
+  tx_hash,
+  height,
+  time,
+  created_at,
+  updated_at
+)
+VALUES @values
+ON CONFLICT (id) DO UPDATE
+SET
+  owner      = excluded.owner,
+  tx_hash    = excluded.tx_hash,
+  height     = excluded.height,
+  time       = excluded.time,
+  updated_at = excluded.updated_at
\ No newline at end of file
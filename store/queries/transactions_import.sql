@@ -9,7 +9,13 @@ INSERT INTO transactions (
   receiver,
   amount,
   fee,
+  token,
+  fee_token,
+  created_token,
+  fee_payer_account_creation_fee,
+  receiver_account_creation_fee,
   memo,
+  memo_raw,
   status,
   canonical,
   failure_reason,
@@ -0,0 +1,58 @@
+WITH ledger_pairs AS (
+  SELECT
+    id,
+    epoch,
+    LAG(id) OVER (ORDER BY epoch) AS previous_id
+  FROM ledgers
+  WHERE type = 'current'
+),
+pairs AS (
+  SELECT * FROM ledger_pairs
+  WHERE
+    previous_id IS NOT NULL
+    AND ($2 < 0 OR epoch = $2)
+)
+SELECT
+  pairs.epoch,
+  'gained' AS change,
+  current.public_key,
+  current.balance,
+  previous.delegate AS previous_delegate,
+  current.delegate AS delegate
+FROM
+  pairs
+INNER JOIN ledger_entries current
+  ON current.ledger_id = pairs.id
+  AND current.delegate = $1
+  AND current.delegation = TRUE
+LEFT JOIN ledger_entries previous
+  ON previous.ledger_id = pairs.previous_id
+  AND previous.public_key = current.public_key
+WHERE
+  previous.delegate IS DISTINCT FROM $1
+
+UNION ALL
+
+SELECT
+  pairs.epoch,
+  'lost' AS change,
+  previous.public_key,
+  previous.balance,
+  previous.delegate AS previous_delegate,
+  current.delegate AS delegate
+FROM
+  pairs
+INNER JOIN ledger_entries previous
+  ON previous.ledger_id = pairs.previous_id
+  AND previous.delegate = $1
+  AND previous.delegation = TRUE
+LEFT JOIN ledger_entries current
+  ON current.ledger_id = pairs.id
+  AND current.public_key = previous.public_key
+WHERE
+  current.delegate IS DISTINCT FROM $1
+
+ORDER BY
+  epoch DESC,
+  change ASC,
+  balance DESC
\ No newline at end of file
@@ -0,0 +1,25 @@
+WITH produced AS (
+  SELECT hash, coinbase
+  FROM blocks
+  WHERE creator = $1 AND epoch = $2 AND canonical = TRUE
+)
+SELECT
+  (SELECT COUNT(1) FROM produced) AS blocks_produced,
+  (SELECT COALESCE(SUM(coinbase), 0) FROM produced) AS coinbase,
+  (
+    SELECT COALESCE(SUM(amount), 0)
+    FROM transactions
+    WHERE
+      block_hash IN (SELECT hash FROM produced)
+      AND type = 'fee_transfer'
+      AND receiver = $1
+      AND canonical = TRUE
+  ) AS fees,
+  (
+    SELECT COALESCE(SUM(amount), 0)
+    FROM transactions
+    WHERE
+      block_hash IN (SELECT hash FROM produced)
+      AND type = 'fee_transfer_via_coinbase'
+      AND canonical = TRUE
+  ) AS snark_fees
\ No newline at end of file
@@ -0,0 +1,23 @@
+WITH current_ledger AS (
+  SELECT * FROM ledger_entries
+  WHERE ledger_id = (
+    SELECT id FROM ledgers
+    WHERE epoch = $2 AND type = 'current'
+    ORDER BY id DESC
+    LIMIT 1
+  )
+)
+SELECT
+  (SELECT COALESCE(SUM(balance), 0) FROM current_ledger WHERE delegate = $1) AS stake,
+  (SELECT COALESCE(SUM(balance), 0) FROM current_ledger) AS total_stake,
+  (SELECT COUNT(1) FROM blocks WHERE creator = $1 AND epoch = $2 AND canonical = TRUE) AS produced_blocks,
+  (
+    SELECT COUNT(1)
+    FROM blocks
+    WHERE
+      creator = $1
+      AND epoch = $2
+      AND canonical = FALSE
+      AND EXISTS (SELECT 1 FROM blocks winners WHERE winners.height = blocks.height AND winners.canonical = TRUE)
+  ) AS orphaned_blocks,
+  COALESCE((SELECT slots_available FROM epochs WHERE epoch = $2), 0) AS slots
\ No newline at end of file
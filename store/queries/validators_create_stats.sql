@@ -1,14 +1,49 @@
-WITH current_delegations AS (
+WITH current_ledger AS (
   SELECT * FROM ledger_entries
   WHERE
     ledger_id = (
       SELECT id FROM ledgers
-      WHERE epoch = (SELECT MAX(epoch) FROM blocks WHERE time >= $1 AND time <= $2)
+      WHERE epoch = (SELECT MAX(epoch) FROM blocks WHERE time >= $1 AND time <= $2) AND type = 'current'
       ORDER BY id DESC
       LIMIT 1
     )
-    AND delegate = $3
+),
+current_delegations AS (
+  SELECT * FROM current_ledger
+  WHERE
+    delegate = $3
     AND delegation IS TRUE
+),
+production AS (
+  SELECT
+    (SELECT COUNT(1) FROM blocks WHERE time >= $1 AND time <= $2 AND creator = $3 AND canonical = true) AS produced,
+    (
+      SELECT COUNT(1)
+      FROM blocks
+      WHERE
+        time >= $1
+        AND time <= $2
+        AND creator = $3
+        AND canonical = false
+        AND EXISTS (SELECT 1 FROM blocks winners WHERE winners.height = blocks.height AND winners.canonical = true)
+    ) AS orphaned,
+    (
+      SELECT COALESCE(SUM(balance::numeric) FILTER (WHERE delegate = $3), 0) / NULLIF(SUM(balance::numeric), 0)
+      FROM current_ledger
+    ) AS stake_share,
+    GREATEST(
+      CEIL(EXTRACT(EPOCH FROM (
+        LEAST($2::timestamp, COALESCE((SELECT MAX(time) FROM blocks WHERE canonical = true), $2::timestamp)) - $1::timestamp
+      )) / $5),
+      0
+    ) AS slots
+),
+expected AS (
+  SELECT
+    produced,
+    orphaned,
+    ROUND(((1 - POWER(1 - $4::numeric, COALESCE(stake_share, 0))) * slots)::numeric, 4) AS blocks
+  FROM production
 )
 INSERT INTO validator_stats (
 	time,
@@ -16,18 +51,27 @@ INSERT INTO validator_stats (
 	validator_id,
 	blocks_produced_count,
 	delegations_count,
-	delegations_amount
+	delegations_amount,
+	blocks_orphaned_count,
+	expected_blocks_count,
+	performance_score
 )
 VALUES (
 	DATE_TRUNC('@bucket', $1::timestamp),
 	'@bucket',
 	(SELECT id FROM validators WHERE public_key = $3 LIMIT 1),
-	(SELECT COUNT(1) FROM blocks WHERE time >= $1 AND time <= $2 AND creator = $3 AND canonical = true),
+	(SELECT produced FROM expected),
   (SELECT COUNT(1) FROM current_delegations),
-  (SELECT COALESCE(SUM(balance::numeric), 0) FROM current_delegations)
+  (SELECT COALESCE(SUM(balance::numeric), 0) FROM current_delegations),
+  (SELECT orphaned FROM expected),
+  (SELECT blocks FROM expected),
+  (SELECT CASE WHEN blocks > 0 THEN ROUND(produced / blocks, 4) ELSE 0 END FROM expected)
 )
 ON CONFLICT (time, bucket, validator_id) DO UPDATE
 SET
   blocks_produced_count = excluded.blocks_produced_count,
 	delegations_count     = excluded.delegations_count,
-	delegations_amount    = excluded.delegations_amount
\ No newline at end of file
+	delegations_amount    = excluded.delegations_amount,
+	blocks_orphaned_count = excluded.blocks_orphaned_count,
+	expected_blocks_count = excluded.expected_blocks_count,
+	performance_score     = excluded.performance_score
\ No newline at end of file
@@ -5,9 +5,9 @@ SELECT
   validators.start_time,
   validators.last_height,
   validators.last_time,
-  COALESCE(validators.stake::TEXT) AS stake,
   validators.blocks_created,
   validators.blocks_proposed,
+  validators.blocks_orphaned,
   validators.delegations,
   COALESCE(validators.stake, 0)::TEXT AS stake,
   COALESCE(accounts.balance, 0)::TEXT AS account_balance,
@@ -16,5 +16,6 @@ FROM
   validators
 LEFT JOIN accounts
   ON accounts.public_key = validators.public_key
+  AND accounts.token = 1
 ORDER BY
   blocks_created DESC
\ No newline at end of file
@@ -0,0 +1,13 @@
+UPDATE validators
+SET
+  blocks_orphaned = (
+    SELECT COUNT(1)
+    FROM blocks
+    WHERE
+      creator = $1
+      AND canonical = false
+      AND EXISTS (SELECT 1 FROM blocks winners WHERE winners.height = blocks.height AND winners.canonical = true)
+  ),
+  updated_at = NOW()
+WHERE
+  public_key = $1
\ No newline at end of file
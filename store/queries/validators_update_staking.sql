@@ -4,7 +4,7 @@ WITH staking AS (
     SUM(balance) AS total,
     COUNT(1) FILTER (WHERE delegation IS TRUE) AS delegations
   FROM ledger_entries
-  WHERE ledger_id = (SELECT id FROM ledgers ORDER BY id DESC LIMIT 1)
+  WHERE ledger_id = (SELECT id FROM ledgers WHERE type = 'current' ORDER BY id DESC LIMIT 1)
   GROUP BY delegate
 )
 UPDATE validators
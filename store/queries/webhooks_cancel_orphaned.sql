@@ -0,0 +1,38 @@
+WITH orphaned AS (
+  SELECT d.id, d.attempts
+  FROM webhook_deliveries d
+  WHERE
+    d.status = 'pending'
+    AND (
+      (
+        d.event IN ('transaction', 'delegation')
+        AND NOT EXISTS (
+          SELECT 1 FROM transactions t
+          WHERE t.hash = d.event_key AND t.block_hash = d.payload->>'block_hash' AND t.canonical = true
+        )
+      )
+      OR (
+        d.event = 'block'
+        AND NOT EXISTS (
+          SELECT 1 FROM blocks b
+          WHERE b.hash = d.event_key AND b.canonical = true
+        )
+      )
+    )
+),
+attempted AS (
+  UPDATE webhook_deliveries
+  SET
+    status     = 'failed',
+    error      = 'event was orphaned',
+    updated_at = NOW()
+  FROM orphaned
+  WHERE
+    webhook_deliveries.id = orphaned.id
+    AND orphaned.attempts > 0
+)
+DELETE FROM webhook_deliveries
+USING orphaned
+WHERE
+  webhook_deliveries.id = orphaned.id
+  AND orphaned.attempts = 0
\ No newline at end of file
@@ -0,0 +1,39 @@
+INSERT INTO webhook_deliveries (
+  subscription_id,
+  event,
+  event_key,
+  payload,
+  next_attempt_at,
+  created_at,
+  updated_at
+)
+SELECT
+  s.id,
+  'block',
+  b.hash,
+  JSON_BUILD_OBJECT(
+    'event', 'block',
+    'hash', b.hash,
+    'height', b.height,
+    'time', b.time,
+    'creator', b.creator,
+    'epoch', b.epoch,
+    'slot', b.slot,
+    'coinbase', b.coinbase::TEXT,
+    'transactions_count', b.transactions_count,
+    'transactions_fees', b.transactions_fees::TEXT
+  ),
+  NOW(),
+  NOW(),
+  NOW()
+FROM
+  webhook_subscriptions s
+INNER JOIN blocks b
+  ON b.creator = s.validator
+WHERE
+  s.active = true
+  AND (s.events IS NULL OR CARDINALITY(s.events) = 0 OR 'block' = ANY(s.events))
+  AND b.canonical = true
+  AND b.height >= $1
+  AND b.time >= s.created_at
+ON CONFLICT DO NOTHING
\ No newline at end of file
@@ -0,0 +1,38 @@
+INSERT INTO webhook_deliveries (
+  subscription_id,
+  event,
+  event_key,
+  payload,
+  next_attempt_at,
+  created_at,
+  updated_at
+)
+SELECT
+  s.id,
+  'delegation',
+  t.hash,
+  JSON_BUILD_OBJECT(
+    'event', 'delegation',
+    'hash', t.hash,
+    'block_hash', t.block_hash,
+    'block_height', t.block_height,
+    'time', t.time,
+    'delegator', t.sender,
+    'delegate', t.receiver,
+    'status', t.status
+  ),
+  NOW(),
+  NOW(),
+  NOW()
+FROM
+  webhook_subscriptions s
+INNER JOIN transactions t
+  ON t.sender = s.account OR t.receiver = s.validator
+WHERE
+  s.active = true
+  AND (s.events IS NULL OR CARDINALITY(s.events) = 0 OR 'delegation' = ANY(s.events))
+  AND t.type = 'delegation'
+  AND t.canonical = true
+  AND t.block_height >= $1
+  AND t.time >= s.created_at
+ON CONFLICT DO NOTHING
\ No newline at end of file
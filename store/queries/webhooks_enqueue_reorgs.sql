@@ -0,0 +1,42 @@
+INSERT INTO webhook_deliveries (
+  subscription_id,
+  event,
+  event_key,
+  payload,
+  next_attempt_at,
+  created_at,
+  updated_at
+)
+SELECT
+  d.subscription_id,
+  'reorg',
+  t.hash || ':' || t.block_hash,
+  JSON_BUILD_OBJECT(
+    'event', 'reorg',
+    'hash', t.hash,
+    'orphaned_block_hash', t.block_hash,
+    'block_height', t.block_height,
+    'canonical_block_hash', winner.hash,
+    'canonical', EXISTS (SELECT 1 FROM transactions c WHERE c.hash = t.hash AND c.canonical = true)
+  ),
+  NOW(),
+  NOW(),
+  NOW()
+FROM
+  webhook_deliveries d
+INNER JOIN webhook_subscriptions s
+  ON s.id = d.subscription_id
+INNER JOIN transactions t
+  ON t.hash = d.event_key
+  AND t.block_hash = d.payload->>'block_hash'
+INNER JOIN blocks winner
+  ON winner.height = t.block_height
+  AND winner.canonical = true
+WHERE
+  s.active = true
+  AND (s.events IS NULL OR CARDINALITY(s.events) = 0 OR 'reorg' = ANY(s.events))
+  AND d.event = 'transaction'
+  AND d.attempts > 0
+  AND t.canonical = false
+  AND t.block_height >= $1
+ON CONFLICT DO NOTHING
\ No newline at end of file
@@ -0,0 +1,43 @@
+INSERT INTO webhook_deliveries (
+  subscription_id,
+  event,
+  event_key,
+  payload,
+  next_attempt_at,
+  created_at,
+  updated_at
+)
+SELECT
+  s.id,
+  'transaction',
+  t.hash,
+  JSON_BUILD_OBJECT(
+    'event', 'transaction',
+    'hash', t.hash,
+    'type', t.type,
+    'block_hash', t.block_hash,
+    'block_height', t.block_height,
+    'time', t.time,
+    'sender', t.sender,
+    'receiver', t.receiver,
+    'amount', t.amount::TEXT,
+    'fee', t.fee::TEXT,
+    'memo', t.memo,
+    'status', t.status
+  ),
+  NOW(),
+  NOW(),
+  NOW()
+FROM
+  webhook_subscriptions s
+INNER JOIN transactions t
+  ON t.sender = s.account OR t.receiver = s.account
+WHERE
+  s.active = true
+  AND (s.events IS NULL OR CARDINALITY(s.events) = 0 OR 'transaction' = ANY(s.events))
+  AND (s.tx_type IS NULL OR t.type = s.tx_type)
+  AND (s.min_amount IS NULL OR t.amount >= s.min_amount)
+  AND t.canonical = true
+  AND t.block_height >= $1
+  AND t.time >= s.created_at
+ON CONFLICT DO NOTHING
\ No newline at end of file
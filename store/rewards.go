@@ -0,0 +1,137 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// RewardsStore handles operations on staking rewards
+type RewardsStore struct {
+	baseStore
+}
+
+// EpochIncome returns the validator income from canonical blocks produced in the epoch
+func (s RewardsStore) EpochIncome(validator string, epoch int) (*model.EpochIncome, error) {
+	result := &model.EpochIncome{}
+	err := s.db.Raw(queries.ValidatorEpochIncome, validator, epoch).Scan(result).Error
+	return result, checkErr(err)
+}
+
+// Calculate returns the validator rewards distribution between delegators for the epoch
+func (s RewardsStore) Calculate(validator string, epoch int, fee float64) (*model.RewardSummary, error) {
+	ledger, err := NewStakingStore(s.db).FindLedger(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []model.LedgerEntry{}
+	err = s.db.
+		Where("ledger_id = ? AND delegate = ?", ledger.ID, validator).
+		Order("id ASC").
+		Find(&entries).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	income, err := s.EpochIncome(validator, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CalculateRewards(validator, epoch, fee, *income, entries)
+}
+
+// CalculateWithStored returns the validator rewards distribution, with the persisted payouts if there are any
+func (s RewardsStore) CalculateWithStored(validator string, epoch int, fee float64) (*model.RewardSummary, error) {
+	summary, err := s.Calculate(validator, epoch, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	payouts, err := s.FindPayouts(validator, epoch, fee)
+	if err != nil {
+		return nil, err
+	}
+	if len(payouts) > 0 {
+		summary.Payouts = payouts
+	}
+
+	return summary, nil
+}
+
+// CalculateAndStore returns the validator rewards distribution with the persisted payouts.
+// Payouts are stored on the first calculation for the fee and never updated afterwards.
+func (s RewardsStore) CalculateAndStore(validator string, epoch int, fee float64) (*model.RewardSummary, error) {
+	summary, err := s.Calculate(validator, epoch, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	payouts, err := s.FindPayouts(validator, epoch, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payouts) == 0 && len(summary.Payouts) > 0 {
+		if err := s.ImportPayouts(summary.Payouts); err != nil {
+			return nil, err
+		}
+		if payouts, err = s.FindPayouts(validator, epoch, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	summary.Payouts = payouts
+	return summary, nil
+}
+
+// FindPayouts returns persisted payouts for the validator epoch and fee
+func (s RewardsStore) FindPayouts(validator string, epoch int, fee float64) ([]model.RewardPayout, error) {
+	result := []model.RewardPayout{}
+
+	err := s.db.
+		Where("validator = ? AND epoch = ? AND validator_fee = ?", validator, epoch, fee).
+		Order("reward DESC, id ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// ImportPayouts creates reward payout records in bulk, existing records are kept
+func (s RewardsStore) ImportPayouts(records []model.RewardPayout) error {
+	var err error
+	now := time.Now()
+
+	for i := 0; i < len(records); i += batchSize {
+		j := i + batchSize
+		if j > len(records) {
+			j = len(records)
+		}
+
+		err = bulk.Import(s.db, queries.RewardPayoutsImport, j-i, func(k int) bulk.Row {
+			r := records[i+k]
+
+			return bulk.Row{
+				r.Validator,
+				r.Epoch,
+				r.Delegator,
+				r.Balance,
+				r.Share,
+				r.Reward,
+				r.ValidatorFee,
+				now,
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
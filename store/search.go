@@ -0,0 +1,33 @@
+package store
+
+import (
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/util"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// Minimum length of the input to match public keys by prefix
+const searchPrefixMinLength = 8
+
+// SearchStore handles search across entities
+type SearchStore struct {
+	baseStore
+}
+
+// Search returns entities matching the input, ranked by match quality
+func (s SearchStore) Search(input string, limit uint) ([]model.SearchResult, error) {
+	result := []model.SearchResult{}
+
+	height, err := util.ParseInt64(input)
+	if err != nil {
+		height = -1
+	}
+
+	prefix := ""
+	if len(input) >= searchPrefixMinLength {
+		prefix = escapeLike(input) + "%"
+	}
+
+	err = s.db.Raw(queries.Search, input, height, prefix, limit).Scan(&result).Error
+	return result, checkErr(err)
+}
@@ -39,6 +39,21 @@ func (s JobsStore) ByHash(hash string) ([]model.SnarkJob, error) {
 	return result, err
 }
 
+// ByProver returns a page of jobs completed by the prover, most recent first
+func (s JobsStore) ByProver(prover string, page, limit uint) ([]model.SnarkJob, error) {
+	result := []model.SnarkJob{}
+
+	err := s.db.
+		Where("prover = ?", prover).
+		Order("height DESC, id DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
 func (s JobsStore) Import(jobs []model.SnarkJob) error {
 	if len(jobs) == 0 {
 		return nil
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// SnarkPoolStore handles operations on snark pool snapshots
+type SnarkPoolStore struct {
+	baseStore
+}
+
+// Recent returns the most recent snark pool snapshot
+func (s SnarkPoolStore) Recent() (*model.SnarkPool, error) {
+	result := &model.SnarkPool{}
+	err := s.db.Order("time DESC").Take(result).Error
+	return result, checkErr(err)
+}
+
+// History returns snark pool stats for a given interval
+func (s SnarkPoolStore) History(period uint, interval string) ([]model.SnarkPoolStat, error) {
+	result := []model.SnarkPoolStat{}
+	err := s.db.Raw(queries.SnarkPoolHistory, period, interval).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// Create creates a new snark pool snapshot
+func (s SnarkPoolStore) Create(pool *model.SnarkPool) error {
+	pool.CreatedAt = time.Now()
+	return s.db.Create(pool).Error
+}
@@ -1,13 +1,20 @@
 package store
 
 import (
+	"errors"
+	"time"
+
 	"github.com/figment-networks/indexing-engine/store/bulk"
 	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
 	"github.com/figment-networks/mina-indexer/store/queries"
+	"github.com/jinzhu/gorm"
 )
 
 const batchSize = 100
 
+var errLedgerNotVerified = errors.New("ledger is not verified")
+
 // StakingStore handles operations on staking data
 type StakingStore struct {
 	baseStore
@@ -20,6 +27,118 @@ func (s StakingStore) CreateLedger(ledger *model.Ledger) error {
 
 // CreateLedgerEntries create a batch of ledger entries
 func (s StakingStore) CreateLedgerEntries(records []model.LedgerEntry) error {
+	return createLedgerEntries(s.db, records)
+}
+
+// ImportLedger creates the ledger with all its entries in a single transaction.
+// The verification result is recorded on the ledger and never fails the import.
+func (s StakingStore) ImportLedger(ledger *model.Ledger, entries []model.LedgerEntry) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return importLedger(tx, ledger, entries)
+	})
+}
+
+// ReplaceLedger removes the existing ledger and imports the new one in a single transaction
+func (s StakingStore) ReplaceLedger(existing *model.Ledger, ledger *model.Ledger, entries []model.LedgerEntry) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := deleteLedger(tx, existing); err != nil {
+			return err
+		}
+		return importLedger(tx, ledger, entries)
+	})
+}
+
+// RetryLedger imports a new copy of the ledger that failed the verification.
+// The existing ledger is only replaced when the new copy passes the verification,
+// otherwise the transaction is rolled back and the attempt is recorded on the existing ledger.
+func (s StakingStore) RetryLedger(existing *model.Ledger, ledger *model.Ledger, entries []model.LedgerEntry) (bool, error) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Only one ledger of the epoch and type may exist at a time
+		if err := deleteLedger(tx, existing); err != nil {
+			return err
+		}
+		if err := importLedger(tx, ledger, entries); err != nil {
+			return err
+		}
+		if !ledger.Verified {
+			return errLedgerNotVerified
+		}
+		return nil
+	})
+
+	switch err {
+	case nil:
+		return true, nil
+	case errLedgerNotVerified:
+		return false, s.VerifyLedger(existing)
+	default:
+		return false, err
+	}
+}
+
+// VerifyLedger checks the stored ledger entries against the ledger totals and records the result
+func (s StakingStore) VerifyLedger(ledger *model.Ledger) error {
+	return verifyLedger(s.db, ledger)
+}
+
+func importLedger(db *gorm.DB, ledger *model.Ledger, entries []model.LedgerEntry) error {
+	if err := db.Create(ledger).Error; err != nil {
+		return err
+	}
+
+	for idx := range entries {
+		entries[idx].LedgerID = ledger.ID
+	}
+
+	if err := createLedgerEntries(db, entries); err != nil {
+		return err
+	}
+
+	return verifyLedger(db, ledger)
+}
+
+func verifyLedger(db *gorm.DB, ledger *model.Ledger) error {
+	count, balance, err := ledgerTotals(db, ledger.ID)
+	if err != nil {
+		return err
+	}
+
+	ledger.SetVerification(ledger.Verify(count, balance), time.Now())
+
+	return db.
+		Model(ledger).
+		Updates(map[string]interface{}{
+			"verified":     ledger.Verified,
+			"verify_error": ledger.VerifyError,
+			"verified_at":  ledger.VerifiedAt,
+		}).
+		Error
+}
+
+func deleteLedger(db *gorm.DB, ledger *model.Ledger) error {
+	if err := db.Delete(model.LedgerEntry{}, "ledger_id = ?", ledger.ID).Error; err != nil {
+		return err
+	}
+	return db.Delete(ledger).Error
+}
+
+func ledgerTotals(db *gorm.DB, ledgerID int) (int, types.Amount, error) {
+	var result struct {
+		Count   int
+		Balance types.Amount
+	}
+
+	err := db.
+		Table("ledger_entries").
+		Select("COUNT(1) AS count, COALESCE(SUM(balance), 0) AS balance").
+		Where("ledger_id = ?", ledgerID).
+		Scan(&result).
+		Error
+
+	return result.Count, result.Balance, checkErr(err)
+}
+
+func createLedgerEntries(db *gorm.DB, records []model.LedgerEntry) error {
 	var err error
 	for i := 0; i < len(records); i += batchSize {
 		j := i + batchSize
@@ -27,7 +146,7 @@ func (s StakingStore) CreateLedgerEntries(records []model.LedgerEntry) error {
 			j = len(records)
 		}
 
-		err = bulk.Import(s.db, queries.LedgerImportEntries, j-i, func(k int) bulk.Row {
+		err = bulk.Import(db, queries.LedgerImportEntries, j-i, func(k int) bulk.Row {
 			r := records[i+k]
 
 			return bulk.Row{
@@ -50,13 +169,18 @@ func (s StakingStore) CreateLedgerEntries(records []model.LedgerEntry) error {
 	return nil
 }
 
-// FindLedger returns the most recent ledger of an epoch
+// FindLedger returns the staking ledger of an epoch
 func (s StakingStore) FindLedger(epoch int) (*model.Ledger, error) {
+	return s.FindLedgerByType(epoch, model.LedgerTypeCurrent)
+}
+
+// FindLedgerByType returns the ledger of an epoch with a given type
+func (s StakingStore) FindLedgerByType(epoch int, ledgerType string) (*model.Ledger, error) {
 	ledger := &model.Ledger{}
 
 	err := s.db.
 		Model(ledger).
-		Where("epoch = ?", epoch).
+		Where("epoch = ? AND type = ?", epoch, ledgerType).
 		First(ledger).
 		Error
 
@@ -73,28 +197,34 @@ func (s StakingStore) AllLedgers() ([]model.Ledger, error) {
 
 	err := s.db.
 		Model(&model.Ledger{}).
-		Order("epoch ASC").
+		Order("epoch ASC, type ASC").
 		Find(&result).
 		Error
 
 	return result, err
 }
 
-// LastLedger returns the most recent ledger record
+// LastLedger returns the most recent staking ledger record
 func (s StakingStore) LastLedger() (*model.Ledger, error) {
+	return s.LastLedgerByType(model.LedgerTypeCurrent)
+}
+
+// LastLedgerByType returns the most recent ledger record with a given type
+func (s StakingStore) LastLedgerByType(ledgerType string) (*model.Ledger, error) {
 	ledger := &model.Ledger{}
 
 	err := s.db.
 		Model(ledger).
+		Where("type = ?", ledgerType).
 		Order("id DESC").
 		First(ledger).
 		Error
 
-	if err == ErrNotFound {
+	if err = checkErr(err); err != nil {
 		ledger = nil
 	}
 
-	return ledger, checkErr(err)
+	return ledger, err
 }
 
 type FindDelegationsParams struct {
@@ -116,13 +246,39 @@ func (s StakingStore) LedgerRecords(ledgerID int) ([]model.LedgerEntry, error) {
 	return result, checkErr(err)
 }
 
+// FindLedgerEntry returns a ledger record for a given public key
+func (s StakingStore) FindLedgerEntry(ledgerID int, publicKey string) (*model.LedgerEntry, error) {
+	result := &model.LedgerEntry{}
+
+	err := s.db.
+		Model(result).
+		Where("ledger_id = ? AND public_key = ?", ledgerID, publicKey).
+		Take(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// TimedLedgerRecords returns all ledger records with a vesting schedule
+func (s StakingStore) TimedLedgerRecords(ledgerID int) ([]model.LedgerEntry, error) {
+	result := []model.LedgerEntry{}
+
+	err := s.db.
+		Model(&model.LedgerEntry{}).
+		Where("ledger_id = ? AND timing_initial_minimum_balance > 0", ledgerID).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // FindDelegations returns delegations for a given ledger ID
 func (s StakingStore) FindDelegations(params FindDelegationsParams) ([]model.Delegation, error) {
 	result := []model.Delegation{}
 
 	if params.LedgerID == nil {
 		ledger, err := s.LastLedger()
-		if err != nil && err != ErrNotFound {
+		if err != nil {
 			return result, err
 		}
 		params.LedgerID = &ledger.ID
@@ -144,3 +300,59 @@ func (s StakingStore) FindDelegations(params FindDelegationsParams) ([]model.Del
 
 	return result, checkErr(err)
 }
+
+// DelegationHistory returns the epochs where the account delegate has changed
+func (s StakingStore) DelegationHistory(publicKey string) ([]model.DelegationChange, error) {
+	result := []model.DelegationChange{}
+	err := s.db.Raw(queries.AccountDelegationHistory, publicKey).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// DelegatorChanges returns delegators gained and lost by the validator per epoch.
+// Changes for all epochs are returned when epoch is negative.
+func (s StakingStore) DelegatorChanges(delegate string, epoch int) ([]model.DelegatorChange, error) {
+	result := []model.DelegatorChange{}
+	err := s.db.Raw(queries.ValidatorDelegatorChanges, delegate, epoch).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// LedgerDiff returns the changes between two ledgers, limited to the largest changes
+func (s StakingStore) LedgerDiff(from, to *model.Ledger, limit uint) (*model.LedgerDiff, error) {
+	result := &model.LedgerDiff{
+		From: from.Epoch,
+		To:   to.Epoch,
+	}
+
+	err := s.db.Raw(queries.LedgerDiffSummary, from.ID, to.ID).Scan(&result.Summary).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	err = s.db.Raw(queries.LedgerDiffAccounts, from.ID, to.ID, limit).Scan(&result.Accounts).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	err = s.db.Raw(queries.LedgerDiffValidators, from.ID, to.ID, limit).Scan(&result.Validators).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	return result, nil
+}
+
+// DelegateStake returns the total balance delegated to the delegate in the ledger
+func (s StakingStore) DelegateStake(ledgerID int, delegate string) (types.Amount, error) {
+	var result struct {
+		Stake types.Amount
+	}
+
+	err := s.db.
+		Table("ledger_entries").
+		Select("COALESCE(SUM(balance), 0) AS stake").
+		Where("ledger_id = ? AND delegate = ?", ledgerID, delegate).
+		Scan(&result).
+		Error
+
+	return result.Stake, checkErr(err)
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
+)
+
+func TestRetryLedger(t *testing.T) {
+	examples := []struct {
+		name     string
+		count    int
+		replaced bool
+	}{
+		{name: "unverified copy", count: 1, replaced: false},
+		{name: "verified copy", count: 2, replaced: true},
+	}
+
+	for _, ex := range examples {
+		t.Run(ex.name, func(t *testing.T) {
+			conn, mock, err := sqlmock.New()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer conn.Close()
+
+			db, err := gorm.Open("postgres", conn)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			existing := &model.Ledger{ID: 1, Epoch: 10, Type: model.LedgerTypeCurrent, EntriesCount: 2, StakedAmount: types.NewInt64Amount(200)}
+			ledger := &model.Ledger{Epoch: 10, Type: model.LedgerTypeCurrent, EntriesCount: 2, StakedAmount: types.NewInt64Amount(200)}
+			entries := []model.LedgerEntry{
+				{PublicKey: "B62qone", Balance: types.NewInt64Amount(100)},
+				{PublicKey: "B62qtwo", Balance: types.NewInt64Amount(100)},
+			}
+
+			// Existing ledger is removed before the new copy takes its epoch and type
+			mock.ExpectBegin()
+			mock.ExpectExec(`DELETE FROM "ledger_entries"`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec(`DELETE FROM "ledgers"`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery(`INSERT INTO "ledgers"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+			mock.ExpectExec(`INSERT INTO ledger_entries`).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectQuery(`SELECT COUNT\(1\)`).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"count", "balance"}).AddRow(ex.count, "200"))
+			mock.ExpectExec(`UPDATE "ledgers"`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			if ex.replaced {
+				mock.ExpectCommit()
+			} else {
+				// Existing ledger is restored and the attempt is recorded on it
+				mock.ExpectRollback()
+				mock.ExpectQuery(`SELECT COUNT\(1\)`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count", "balance"}).AddRow(1, "100"))
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "ledgers"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			replaced, err := NewStakingStore(db).RetryLedger(existing, ledger, entries)
+			assert.NoError(t, err)
+			assert.Equal(t, ex.replaced, replaced)
+			assert.Equal(t, ex.replaced, ledger.Verified)
+			assert.NoError(t, mock.ExpectationsWereMet())
+
+			if !ex.replaced {
+				assert.False(t, existing.Verified)
+				assert.NotNil(t, existing.VerifiedAt)
+				assert.NotNil(t, existing.VerifyError)
+			}
+		})
+	}
+}
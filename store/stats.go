@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jinzhu/gorm"
+
 	"github.com/figment-networks/mina-indexer/model"
 	"github.com/figment-networks/mina-indexer/model/util"
 	"github.com/figment-networks/mina-indexer/store/queries"
@@ -26,18 +28,21 @@ func (s StatsStore) CreateChainStats(bucket string, ts time.Time) error {
 		return err
 	}
 
-	err = s.db.Exec(
-		s.prepareBucket(sqlChainStatsDelete, bucket),
-		start,
-	).Error
-	if err != nil && err != ErrNotFound {
-		return err
-	}
-
-	return s.db.Exec(
-		s.prepareBucket(queries.ChainStatsImport, bucket),
-		start, end,
-	).Error
+	// Stats of the bucket are replaced at once, readers never see the bucket missing
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(
+			s.prepareBucket(sqlChainStatsDelete, bucket),
+			start,
+		).Error
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+
+		return tx.Exec(
+			s.prepareBucket(queries.ChainStatsImport, bucket),
+			start, end,
+		).Error
+	})
 }
 
 // CreateValidatorStats creates a new validator stats record
@@ -50,6 +55,8 @@ func (s StatsStore) CreateValidatorStats(validatorPublicKey string, bucket strin
 	return s.db.Exec(
 		s.prepareBucket(queries.ValidatorsCreateStats, bucket),
 		start, end, validatorPublicKey,
+		model.ActiveSlotCoefficient,
+		model.SlotDuration.Seconds(),
 	).Error
 }
 
@@ -68,6 +75,34 @@ func (s StatsStore) ValidatorStats(validator *model.Validator, period uint, inte
 	return result, err
 }
 
+// CreateSnarkerStats creates a new snarker stats record
+func (s StatsStore) CreateSnarkerStats(account string, bucket string, ts time.Time) error {
+	start, end, err := s.getTimeRange(bucket, ts)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Exec(
+		s.prepareBucket(queries.SnarkersCreateStats, bucket),
+		start, end, account,
+	).Error
+}
+
+// SnarkerStats returns snarker stats for a given timeframe
+func (s StatsStore) SnarkerStats(snarker *model.Snarker, period uint, interval string) ([]model.SnarkerStat, error) {
+	result := []model.SnarkerStat{}
+
+	err := s.db.
+		Table("snarker_stats").
+		Where("snarker_id = ? AND bucket = ?", snarker.ID, interval).
+		Order("time DESC").
+		Limit(period).
+		Find(&result).
+		Error
+
+	return result, err
+}
+
 // FindValidatorsForDefaultStats returns validator for default values
 func (s StatsStore) FindValidatorsForDefaultStats(bucket string, ts time.Time) ([]model.Validator, error) {
 	start, _, err := s.getTimeRange(bucket, ts)
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateChainStats(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+
+	db, err := gorm.Open("postgres", conn)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Deleted stats are restored when the import fails
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM chain_stats`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO chain_stats`).WillReturnError(errors.New("import failed"))
+	mock.ExpectRollback()
+
+	err = NewStatsStore(db).CreateChainStats(BucketHour, time.Now())
+	assert.EqualError(t, err, "import failed")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
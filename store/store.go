@@ -21,6 +21,14 @@ type Store struct {
 	Snarkers     SnarkersStore
 	Stats        StatsStore
 	Staking      StakingStore
+	Supply       SupplyStore
+	Rewards      RewardsStore
+	Epochs       EpochsStore
+	SnarkPool    SnarkPoolStore
+	Tokens       TokensStore
+	Search       SearchStore
+	Webhooks     WebhooksStore
+	Events       EventsStore
 }
 
 // Test checks the connection status
@@ -61,6 +69,14 @@ func New(connStr string) (*Store, error) {
 		Jobs:         NewJobsStore(conn),
 		Stats:        NewStatsStore(conn),
 		Staking:      NewStakingStore(conn),
+		Supply:       NewSupplyStore(conn),
+		Rewards:      NewRewardsStore(conn),
+		Epochs:       NewEpochsStore(conn),
+		SnarkPool:    NewSnarkPoolStore(conn),
+		Tokens:       NewTokensStore(conn),
+		Search:       NewSearchStore(conn),
+		Webhooks:     NewWebhooksStore(conn),
+		Events:       NewEventsStore(conn),
 	}, nil
 }
 
@@ -95,3 +111,35 @@ func NewStatsStore(db *gorm.DB) StatsStore {
 func NewStakingStore(db *gorm.DB) StakingStore {
 	return StakingStore{scoped(db, nil)}
 }
+
+func NewSupplyStore(db *gorm.DB) SupplyStore {
+	return SupplyStore{scoped(db, model.Supply{})}
+}
+
+func NewRewardsStore(db *gorm.DB) RewardsStore {
+	return RewardsStore{scoped(db, model.RewardPayout{})}
+}
+
+func NewEpochsStore(db *gorm.DB) EpochsStore {
+	return EpochsStore{scoped(db, model.Epoch{})}
+}
+
+func NewSnarkPoolStore(db *gorm.DB) SnarkPoolStore {
+	return SnarkPoolStore{scoped(db, model.SnarkPool{})}
+}
+
+func NewTokensStore(db *gorm.DB) TokensStore {
+	return TokensStore{scoped(db, model.Token{})}
+}
+
+func NewSearchStore(db *gorm.DB) SearchStore {
+	return SearchStore{scoped(db, nil)}
+}
+
+func NewWebhooksStore(db *gorm.DB) WebhooksStore {
+	return WebhooksStore{scoped(db, model.WebhookSubscription{})}
+}
+
+func NewEventsStore(db *gorm.DB) EventsStore {
+	return EventsStore{scoped(db, nil)}
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// SupplyStore handles operations on currency supply
+type SupplyStore struct {
+	baseStore
+}
+
+// Recent returns the most recent supply record
+func (s SupplyStore) Recent() (*model.Supply, error) {
+	result := &model.Supply{}
+	err := s.db.Order("height DESC").Take(result).Error
+	return result, checkErr(err)
+}
+
+// History returns supply stats for a given interval
+func (s SupplyStore) History(period uint, interval string) ([]model.SupplyStat, error) {
+	result := []model.SupplyStat{}
+	err := s.db.Raw(queries.SupplyHistory, period, interval).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// MissingBlocks returns the most recent canonical blocks without supply records, with an existing epoch ledger
+func (s SupplyStore) MissingBlocks(limit int) ([]model.Block, error) {
+	result := []model.Block{}
+	err := s.db.Raw(queries.SupplyMissingBlocks, limit).Scan(&result).Error
+	return result, checkErr(err)
+}
+
+// Import creates or updates supply records in bulk
+func (s SupplyStore) Import(records []model.Supply) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	return bulk.Import(s.db, queries.SupplyImport, len(records), func(idx int) bulk.Row {
+		r := records[idx]
+
+		return bulk.Row{
+			r.Height,
+			r.BlockHash,
+			r.Time,
+			r.Epoch,
+			r.Slot,
+			r.TotalSupply,
+			r.LockedSupply,
+			r.CirculatingSupply,
+			now,
+		}
+	})
+}
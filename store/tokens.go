@@ -0,0 +1,57 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/indexing-engine/store/bulk"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// TokensStore handles operations on tokens
+type TokensStore struct {
+	baseStore
+}
+
+// All returns all created tokens
+func (s TokensStore) All() ([]model.Token, error) {
+	result := []model.Token{}
+
+	err := s.db.
+		Order("id ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindByID returns a token for the ID
+func (s TokensStore) FindByID(id uint64) (*model.Token, error) {
+	result := &model.Token{}
+	err := findBy(s.db, result, "id", id)
+	return result, checkErr(err)
+}
+
+// Import creates or updates tokens in bulk
+func (s TokensStore) Import(records []model.Token) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	return bulk.Import(s.db, queries.TokensImport, len(records), func(idx int) bulk.Row {
+		t := records[idx]
+
+		return bulk.Row{
+			t.ID,
+			t.Owner,
+			t.TxHash,
+			t.Height,
+			t.Time,
+			now,
+			now,
+		}
+	})
+}
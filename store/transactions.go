@@ -7,7 +7,9 @@ import (
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
 	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/types"
 	"github.com/figment-networks/mina-indexer/store/queries"
+	"github.com/jinzhu/gorm"
 )
 
 // TransactionsStore handles operations on transactions
@@ -44,11 +46,50 @@ func (s TransactionsStore) ByHeight(height uint64, limit uint) ([]model.Transact
 	return s.Search(TransactionSearch{Height: height, Limit: limit, Canonical: &canonical})
 }
 
+// ByBlockHash returns canonical transactions for a given block hash
+func (s TransactionsStore) ByBlockHash(hash string) ([]model.Transaction, error) {
+	result := []model.Transaction{}
+
+	err := s.db.
+		Where("block_hash = ? AND canonical = true", hash).
+		Order("id ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // Search returns a list of transactions that matches the filters
 func (s TransactionsStore) Search(search TransactionSearch) ([]model.Transaction, error) {
-	scope := s.db.
-		Order("time DESC").
-		Limit(search.Limit)
+	result := []model.Transaction{}
+	err := s.searchScope(search).Limit(search.Limit).Find(&result).Error
+
+	return result, err
+}
+
+// Export streams all transactions matching the search filters, ignoring the search limit
+func (s TransactionsStore) Export(search TransactionSearch, fn func(model.Transaction) error) error {
+	rows, err := s.searchScope(search).Model(&model.Transaction{}).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		tx := model.Transaction{}
+		if err := s.db.ScanRows(rows, &tx); err != nil {
+			return err
+		}
+		if err := fn(tx); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (s TransactionsStore) searchScope(search TransactionSearch) *gorm.DB {
+	scope := s.db.Order("time DESC")
 
 	if search.BeforeID > 0 {
 		scope = scope.Where("id < ?", search.BeforeID)
@@ -75,8 +116,20 @@ func (s TransactionsStore) Search(search TransactionSearch) ([]model.Transaction
 			scope = scope.Where("receiver = ?", search.Receiver)
 		}
 	}
-	if len(search.Memo) > 2 {
-		scope = scope.Where("memo ILIKE ?", fmt.Sprintf("%%%s%%", search.Memo))
+	if search.Memo != "" {
+		switch search.MemoMode {
+		case MemoModeExact:
+			scope = scope.Where("LOWER(memo) = ?", search.Memo)
+		case MemoModePrefix:
+			scope = scope.Where("memo ILIKE ?", escapeLike(search.Memo)+"%")
+		case MemoModeFullText:
+			scope = scope.Where("TO_TSVECTOR('simple', COALESCE(memo, '')) @@ PLAINTO_TSQUERY('simple', ?)", search.Memo)
+		default:
+			scope = scope.Where("memo ILIKE ?", fmt.Sprintf("%%%s%%", escapeLike(search.Memo)))
+		}
+	}
+	if search.Token != nil {
+		scope = scope.Where("token = ?", *search.Token)
 	}
 	if search.Status != "" {
 		scope = scope.Where("status = ?", search.Status)
@@ -91,10 +144,20 @@ func (s TransactionsStore) Search(search TransactionSearch) ([]model.Transaction
 		scope = scope.Where("canonical = ?", *search.Canonical)
 	}
 
-	result := []model.Transaction{}
-	err := scope.Find(&result).Error
+	return scope
+}
 
-	return result, err
+// RecentFees returns the fees of user commands included in the most recent canonical blocks
+func (s TransactionsStore) RecentFees(blocks uint) ([]types.Amount, error) {
+	result := []types.Amount{}
+
+	err := s.db.
+		Where("canonical = ? AND type IN (?)", true, []string{model.TxTypePayment, model.TxTypeDelegation}).
+		Where("block_height > (SELECT MAX(height) FROM blocks WHERE canonical = ?) - ?", true, blocks).
+		Pluck("fee", &result).
+		Error
+
+	return result, checkErr(err)
 }
 
 func (s TransactionsStore) Import(records []model.Transaction) error {
@@ -117,7 +180,13 @@ func (s TransactionsStore) Import(records []model.Transaction) error {
 			tx.Receiver,
 			tx.Amount,
 			tx.Fee,
+			tx.Token,
+			tx.FeeToken,
+			tx.CreatedToken,
+			tx.FeePayerAccountCreationFee,
+			tx.ReceiverAccountCreationFee,
 			tx.Memo,
+			tx.MemoRaw,
 			tx.Status,
 			tx.Canonical,
 			tx.FailureReason,
@@ -13,22 +13,32 @@ var (
 	reDate = regexp.MustCompile(`^[\d]{4}-[\d]{2}-[\d]{2}$`)
 )
 
+const (
+	// Memo search modes
+	MemoModeContains = "contains"
+	MemoModeExact    = "exact"
+	MemoModePrefix   = "prefix"
+	MemoModeFullText = "fulltext"
+)
+
 // TransactionSearch contains transaction search params
 type TransactionSearch struct {
-	AfterID   uint   `form:"after_id"`
-	BeforeID  uint   `form:"before_id"`
-	Height    uint64 `form:"height"`
-	Type      string `form:"type"`
-	BlockHash string `form:"block_hash"`
-	Account   string `form:"account"`
-	Sender    string `form:"sender"`
-	Receiver  string `form:"receiver"`
-	Memo      string `form:"memo"`
-	StartTime string `form:"start_time"`
-	EndTime   string `form:"end_time"`
-	Status    string `form:"status"`
-	Canonical *bool  `form:"canonical"`
-	Limit     uint   `form:"limit"`
+	AfterID   uint    `form:"after_id"`
+	BeforeID  uint    `form:"before_id"`
+	Height    uint64  `form:"height"`
+	Type      string  `form:"type"`
+	BlockHash string  `form:"block_hash"`
+	Account   string  `form:"account"`
+	Sender    string  `form:"sender"`
+	Receiver  string  `form:"receiver"`
+	Memo      string  `form:"memo"`
+	MemoMode  string  `form:"memo_mode"`
+	StartTime string  `form:"start_time"`
+	EndTime   string  `form:"end_time"`
+	Status    string  `form:"status"`
+	Token     *uint64 `form:"token"`
+	Canonical *bool   `form:"canonical"`
+	Limit     uint    `form:"limit"`
 
 	startTime *time.Time
 	endTime   *time.Time
@@ -93,6 +103,19 @@ func (s *TransactionSearch) Validate() error {
 
 	s.Memo = strings.TrimSpace(strings.ToLower(s.Memo))
 
+	switch s.MemoMode {
+	case MemoModeContains, MemoModeExact, MemoModePrefix, MemoModeFullText:
+	case "":
+		s.MemoMode = MemoModeContains
+	default:
+		return errors.New("invalid memo mode")
+	}
+
+	// Trigram index can't be used for shorter substrings, so they are ignored
+	if s.MemoMode == MemoModeContains && len(s.Memo) < 3 {
+		s.Memo = ""
+	}
+
 	return nil
 }
 
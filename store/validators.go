@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/figment-networks/indexing-engine/store/bulk"
-	"github.com/figment-networks/indexing-engine/store/jsonquery"
 
 	"github.com/figment-networks/mina-indexer/model"
 	"github.com/figment-networks/mina-indexer/store/queries"
@@ -15,8 +14,10 @@ type ValidatorsStore struct {
 	baseStore
 }
 
-func (s ValidatorsStore) Index() ([]byte, error) {
-	return jsonquery.MustArray(s.db, queries.ValidatorsIndex)
+func (s ValidatorsStore) Index() ([]model.ValidatorSummary, error) {
+	result := []model.ValidatorSummary{}
+	err := s.db.Raw(queries.ValidatorsIndex).Scan(&result).Error
+	return result, checkErr(err)
 }
 
 // FindAll returns all available validators
@@ -32,6 +33,27 @@ func (s ValidatorsStore) FindByPublicKey(key string) (*model.Validator, error) {
 	return result, checkErr(err)
 }
 
+// Performance returns the expected and actual block production in the epoch
+func (s ValidatorsStore) Performance(key string, epoch int) (*model.ValidatorPerformance, error) {
+	result := &model.ValidatorPerformance{}
+
+	err := s.db.Raw(queries.ValidatorEpochPerformance, key, epoch).Scan(result).Error
+	if err != nil {
+		return nil, checkErr(err)
+	}
+
+	result.Validator = key
+	result.Epoch = epoch
+	result.Calculate()
+
+	return result, nil
+}
+
+// UpdateOrphanedBlocks refreshes the orphaned blocks count of the validator
+func (s ValidatorsStore) UpdateOrphanedBlocks(key string) error {
+	return s.db.Exec(queries.ValidatorsUpdateOrphaned, key).Error
+}
+
 func (s ValidatorsStore) UpdateStaking() error {
 	return s.db.Exec(queries.ValidatorsUpdateStaking).Error
 }
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store/queries"
+)
+
+// WebhooksStore handles operations on webhook subscriptions and deliveries
+type WebhooksStore struct {
+	baseStore
+}
+
+// CreateSubscription creates a new webhook subscription
+func (s WebhooksStore) CreateSubscription(sub *model.WebhookSubscription) error {
+	now := time.Now()
+
+	sub.Active = true
+	sub.CreatedAt = now
+	sub.UpdatedAt = now
+
+	return checkErr(s.db.Create(sub).Error)
+}
+
+// FindSubscription returns a webhook subscription by ID
+func (s WebhooksStore) FindSubscription(id int) (*model.WebhookSubscription, error) {
+	result := &model.WebhookSubscription{}
+	err := findBy(s.db, result, "id", id)
+	return result, checkErr(err)
+}
+
+// Subscriptions returns all webhook subscriptions
+func (s WebhooksStore) Subscriptions() ([]model.WebhookSubscription, error) {
+	result := []model.WebhookSubscription{}
+
+	err := s.db.
+		Order("id ASC").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// DeleteSubscription removes the webhook subscription and its deliveries
+func (s WebhooksStore) DeleteSubscription(id int) error {
+	return s.db.Delete(model.WebhookSubscription{}, "id = ?", id).Error
+}
+
+// Enqueue creates pending deliveries for all events starting at the given height
+func (s WebhooksStore) Enqueue(startHeight uint64) error {
+	for _, query := range []string{
+		queries.WebhooksEnqueueTransactions,
+		queries.WebhooksEnqueueBlocks,
+		queries.WebhooksEnqueueDelegations,
+		queries.WebhooksEnqueueReorgs,
+	} {
+		if err := s.db.Exec(query, startHeight).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelOrphaned drops the pending deliveries of events that are no longer canonical.
+// Deliveries that were already attempted are marked as failed instead, so the
+// reorg event can still be enqueued for them.
+func (s WebhooksStore) CancelOrphaned() error {
+	return s.db.Exec(queries.WebhooksCancelOrphaned).Error
+}
+
+// PendingDeliveries returns deliveries due for the next attempt
+func (s WebhooksStore) PendingDeliveries(now time.Time, limit uint) ([]model.WebhookDelivery, error) {
+	result := []model.WebhookDelivery{}
+
+	err := s.db.
+		Where("status = ? AND next_attempt_at <= ?", model.WebhookStatusPending, now).
+		Order("next_attempt_at ASC, id ASC").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// UpdateDelivery saves the delivery attempt result
+func (s WebhooksStore) UpdateDelivery(delivery *model.WebhookDelivery) error {
+	delivery.UpdatedAt = time.Now()
+
+	err := s.db.
+		Model(delivery).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_code":   delivery.ResponseCode,
+			"error":           delivery.Error,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+			"updated_at":      delivery.UpdatedAt,
+		}).
+		Error
+
+	return checkErr(err)
+}
+
+// Deliveries returns a page of the subscription deliveries, most recent first
+func (s WebhooksStore) Deliveries(subscriptionID int, status string, page, limit uint) ([]model.WebhookDelivery, error) {
+	result := []model.WebhookDelivery{}
+
+	scope := s.db.Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		scope = scope.Where("status = ?", status)
+	}
+
+	err := scope.
+		Order("id DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
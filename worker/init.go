@@ -32,7 +32,7 @@ func RunInit(cfg *config.Config, db *store.Store) error {
 			PublicKey:      a.PK,
 			Balance:        types.NewInt64Amount(0),
 			BalanceUnknown: types.NewInt64Amount(0),
-			Stake:          types.NewFloatAmount(a.Balance),
+			Stake:          types.NewMINAAmount(a.Balance),
 			Delegate:       a.Delegate,
 			StartHeight:    0,
 			StartTime:      genesis.Config.Timestamp,
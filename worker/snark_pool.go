@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/client/graph"
+	"github.com/figment-networks/mina-indexer/config"
+	"github.com/figment-networks/mina-indexer/model/mapper"
+	"github.com/figment-networks/mina-indexer/store"
+)
+
+// RunSnarkPool records a snapshot of the node snark work pool
+func RunSnarkPool(cfg *config.Config, db *store.Store, client *graph.Client) error {
+	completed, err := client.GetSnarkPool()
+	if err != nil {
+		return err
+	}
+
+	pending, err := client.GetPendingSnarkWork()
+	if err != nil {
+		return err
+	}
+
+	pool := mapper.SnarkPool(time.Now(), completed, pending)
+
+	log.
+		WithField("completed", pool.CompletedWorksCount).
+		WithField("pending", pool.PendingWorksCount).
+		WithField("provers", pool.ProversCount).
+		Debug("recording snark pool")
+
+	return db.SnarkPool.Create(pool)
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/config"
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/model/mapper"
+	"github.com/figment-networks/mina-indexer/model/util"
+	"github.com/figment-networks/mina-indexer/store"
+)
+
+const supplyBatchSize = 500
+
+// RunSupply computes the currency supply for canonical blocks that don't have it yet
+func RunSupply(cfg *config.Config, db *store.Store) error {
+	blocks, err := db.Supply.MissingBlocks(supplyBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	log.WithField("count", len(blocks)).Info("computing supply")
+
+	// Timed ledger entries are cached per epoch, vesting schedules don't change
+	epochEntries := map[int][]model.LedgerEntry{}
+	missingLedgers := map[int]bool{}
+	records := []model.Supply{}
+	computed := []model.Block{}
+
+	for _, block := range blocks {
+		if missingLedgers[block.Epoch] {
+			continue
+		}
+
+		entries, ok := epochEntries[block.Epoch]
+		if !ok {
+			entries, err = timedLedgerRecords(db, block.Epoch)
+			if err == store.ErrNotFound {
+				// Locked supply can't be computed without the epoch ledger, the block stays missing
+				log.WithField("epoch", block.Epoch).Warn("staking ledger is missing, skipping supply")
+				missingLedgers[block.Epoch] = true
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			epochEntries[block.Epoch] = entries
+		}
+
+		records = append(records, *mapper.Supply(&block, entries))
+		computed = append(computed, block)
+	}
+
+	if err := db.Supply.Import(records); err != nil {
+		return err
+	}
+
+	// Refresh chain stats for all affected time buckets
+	buckets := map[string]map[time.Time]bool{
+		store.BucketHour: {},
+		store.BucketDay:  {},
+	}
+	for _, block := range computed {
+		hour, _ := util.HourInterval(block.Time)
+		day, _ := util.DayInterval(block.Time)
+
+		buckets[store.BucketHour][hour] = true
+		buckets[store.BucketDay][day] = true
+	}
+
+	for bucket, times := range buckets {
+		for ts := range times {
+			log.WithField("bucket", bucket).Debug("updating chain stats supply")
+			if err := db.Stats.CreateChainStats(bucket, ts); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func timedLedgerRecords(db *store.Store, epoch int) ([]model.LedgerEntry, error) {
+	ledger, err := db.Staking.FindLedger(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Staking.TimedLedgerRecords(ledger.ID)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -17,7 +18,12 @@ import (
 	"github.com/figment-networks/mina-indexer/store"
 )
 
-const unsafeBlockThreshold = 15
+const (
+	unsafeBlockThreshold = 15
+
+	// ledgerRetryInterval is the delay before fetching a ledger that failed the verification again
+	ledgerRetryInterval = time.Hour
+)
 
 type SyncWorker struct {
 	cfg           *config.Config
@@ -54,6 +60,12 @@ func (w SyncWorker) Run() (int, error) {
 		return 0, err
 	}
 
+	log.Info("processing next epoch ledger")
+	if _, err := w.processNextLedger(); err != nil {
+		log.WithError(err).Error("next epoch ledger processing failed")
+		// do not abort here
+	}
+
 	log.Info("fetching the most recent indexed block")
 	lastBlock, err := w.db.Blocks.Recent()
 	if err != nil {
@@ -115,7 +127,7 @@ func (w SyncWorker) Run() (int, error) {
 		return 0, err
 	}
 	for _, block := range canonicalBlocks {
-		_, err := w.db.Blocks.FindByHash(block.StateHash)
+		existing, err := w.db.Blocks.FindByHash(block.StateHash)
 		if err != nil {
 			if err != store.ErrNotFound {
 				return 0, err
@@ -123,6 +135,21 @@ func (w SyncWorker) Run() (int, error) {
 			if err := w.processBlock(block.StateHash); err != nil {
 				return 0, err
 			}
+			existing = nil
+		}
+
+		// Blocks demoted at this height are only known before marking them as orphans
+		demoted := []string{}
+		if existing == nil || !existing.Canonical {
+			siblings, err := w.db.Blocks.Siblings(block.Height)
+			if err != nil {
+				return 0, err
+			}
+			for _, sibling := range siblings {
+				if sibling.Canonical && sibling.Hash != block.StateHash {
+					demoted = append(demoted, sibling.Hash)
+				}
+			}
 		}
 
 		if err := w.db.Blocks.MarkBlocksOrphan(block.Height); err != nil {
@@ -137,6 +164,22 @@ func (w SyncWorker) Run() (int, error) {
 		if err := w.db.Transactions.MarkTransactionsCanonical(block.StateHash); err != nil {
 			return 0, err
 		}
+
+		if existing == nil || !existing.Canonical {
+			if err := w.db.Accounts.RevertCreations(block.Height); err != nil {
+				return 0, err
+			}
+			for _, hash := range demoted {
+				if err := w.publishOrphan(hash); err != nil {
+					log.WithError(err).Error("orphan events publishing failed")
+					// do not abort here
+				}
+			}
+			if err := w.publishCanonical(block.StateHash); err != nil {
+				log.WithError(err).Error("canonical events publishing failed")
+				// do not abort here
+			}
+		}
 	}
 
 	log.Info("correcting canonical blocks and validators statistics")
@@ -167,6 +210,12 @@ func (w SyncWorker) Run() (int, error) {
 		}
 	}
 
+	for _, key := range validatorKeys {
+		if err := w.db.Validators.UpdateOrphanedBlocks(key); err != nil {
+			return 0, err
+		}
+	}
+
 	for _, block := range blockKeys {
 		ts := block.Time
 		buckets := []string{store.BucketHour, store.BucketDay}
@@ -183,15 +232,34 @@ func (w SyncWorker) Run() (int, error) {
 					return 0, err
 				}
 			}
+
+			log.WithField("bucket", bucket).Debug("creating snarker stats")
+			for _, account := range block.SnarkerAccounts {
+				if err := w.db.Stats.CreateSnarkerStats(account, bucket, ts); err != nil {
+					return 0, err
+				}
+			}
 		}
 	}
 
+	log.Info("enqueueing webhook deliveries")
+	if err := w.db.Webhooks.Enqueue(startingBlock); err != nil {
+		log.WithError(err).Error("webhook enqueue failed")
+		// do not abort here
+	}
+
 	log.Info("processing staging ledger")
 	if err := w.processStagingLedger(); err != nil {
 		log.WithError(err).Error("staging ledger processing failed")
 		// do not abort here
 	}
 
+	log.Info("updating account creations")
+	if err := w.db.Accounts.UpdateCreations(uint64(blocksRequest.StartHeight)); err != nil {
+		log.WithError(err).Error("account creations update failed")
+		// do not abort here
+	}
+
 	var lag int
 
 	if len(blocks) > 0 {
@@ -236,9 +304,54 @@ func (w SyncWorker) processBlock(hash string) error {
 		return err
 	}
 
+	if err := w.db.Events.Publish(model.NewBlockStreamEvent(*data.Block)); err != nil {
+		log.WithError(err).Error("block event publishing failed")
+		// do not abort here
+	}
+
 	return nil
 }
 
+// publishCanonical notifies the stream clients about the block and its transactions becoming canonical
+func (w SyncWorker) publishCanonical(hash string) error {
+	block, err := w.db.Blocks.FindByHash(hash)
+	if err != nil {
+		return err
+	}
+
+	transactions, err := w.db.Transactions.ByBlockHash(hash)
+	if err != nil {
+		return err
+	}
+
+	events := []model.StreamEvent{model.NewCanonicalStreamEvent(*block)}
+	for _, tx := range transactions {
+		events = append(events, model.NewTransactionStreamEvent(tx))
+	}
+
+	return w.db.Events.Publish(events...)
+}
+
+// publishOrphan notifies the stream clients about the block and its transactions being orphaned
+func (w SyncWorker) publishOrphan(hash string) error {
+	block, err := w.db.Blocks.FindByHash(hash)
+	if err != nil {
+		return err
+	}
+
+	transactions, err := w.db.Transactions.ByBlockHash(hash)
+	if err != nil {
+		return err
+	}
+
+	events := []model.StreamEvent{model.NewOrphanStreamEvent(*block)}
+	for _, tx := range transactions {
+		events = append(events, model.NewOrphanTransactionStreamEvent(tx))
+	}
+
+	return w.db.Events.Publish(events...)
+}
+
 func (w SyncWorker) checkNodeStatus() (*graph.DaemonStatus, error) {
 	log.Debug("fetching node status")
 	status, err := w.graphClient.GetDaemonStatus(context.Background())
@@ -263,6 +376,14 @@ func (w SyncWorker) checkNodeStatus() (*graph.DaemonStatus, error) {
 }
 
 func (w SyncWorker) processStakingLedger() (*mapper.LedgerData, error) {
+	return w.processLedger(archive.LedgerTypeCurrent, model.LedgerTypeCurrent)
+}
+
+func (w SyncWorker) processNextLedger() (*mapper.LedgerData, error) {
+	return w.processLedger(archive.LedgerTypeNext, model.LedgerTypeNext)
+}
+
+func (w SyncWorker) processLedger(archiveType string, ledgerType string) (*mapper.LedgerData, error) {
 	tip, err := w.graphClient.ConsensusTip()
 	if err != nil {
 		return nil, err
@@ -270,47 +391,59 @@ func (w SyncWorker) processStakingLedger() (*mapper.LedgerData, error) {
 
 	var epoch int
 	fmt.Sscanf(tip.ProtocolState.ConsensusState.Epoch, "%d", &epoch)
+	if ledgerType == model.LedgerTypeNext {
+		epoch++
+	}
 
-	// Find ledger for current epoch. Ledger only changes once per epoch.
-	currentLedger, err := w.db.Staking.FindLedger(epoch)
+	// Find ledger for the epoch. Ledger only changes once per epoch.
+	currentLedger, err := w.db.Staking.FindLedgerByType(epoch, ledgerType)
 	if err != nil && err != store.ErrNotFound {
 		return nil, err
 	}
 
-	// We already have current epoch ledger, no need to import it.
-	if currentLedger != nil && currentLedger.EntriesCount > 0 {
-		records, err := w.db.Staking.LedgerRecords(currentLedger.ID)
-		if err != nil && err != store.ErrNotFound || len(records) > 0 {
+	// We already have a verified epoch ledger, no need to import it.
+	// Ledgers that failed the verification are fetched again once in a while.
+	if currentLedger != nil {
+		if currentLedger.Verified {
+			return nil, nil
+		}
+		if currentLedger.VerifiedAt != nil && time.Since(*currentLedger.VerifiedAt) < ledgerRetryInterval {
 			return nil, nil
 		}
 	}
 
-	ledger, err := w.archiveClient.StakingLedger(archive.LedgerTypeCurrent)
+	ledger, err := w.archiveClient.StakingLedger(archiveType)
 	if err != nil {
 		return nil, err
 	}
 
-	ledgerData, err := mapper.Ledger(tip, ledger)
+	ledgerData, err := mapper.Ledger(tip, ledgerType, ledger)
 	if err != nil {
 		return nil, err
 	}
 
+	logger := log.
+		WithField("epoch", ledgerData.Ledger.Epoch).
+		WithField("type", ledgerType)
+
 	if currentLedger == nil {
-		err = w.db.Staking.CreateLedger(ledgerData.Ledger)
-		if err != nil {
-			return nil, err
-		}
+		err = w.db.Staking.ImportLedger(ledgerData.Ledger, ledgerData.Entries)
 	} else {
-		ledgerData.Ledger = currentLedger
+		var replaced bool
+		replaced, err = w.db.Staking.RetryLedger(currentLedger, ledgerData.Ledger, ledgerData.Entries)
+		if err == nil && !replaced {
+			logger.Warn("ledger copy failed verification, keeping the existing ledger")
+			return nil, nil
+		}
 	}
-
-	ledgerData.UpdateLedgerID()
-
-	err = w.db.Staking.CreateLedgerEntries(ledgerData.Entries)
 	if err != nil {
 		return nil, err
 	}
 
+	if !ledgerData.Ledger.Verified {
+		logger.WithField("error", *ledgerData.Ledger.VerifyError).Warn("ledger verification failed")
+	}
+
 	return ledgerData, nil
 }
 
@@ -343,5 +476,10 @@ func (w SyncWorker) processStagingLedger() error {
 		accounts = append(accounts, *account)
 	}
 
-	return w.db.Accounts.Import(accounts)
+	// Snapshots are compared with the accounts state before the import
+	if err := w.db.Accounts.ImportStagedBalances(accounts); err != nil {
+		return err
+	}
+
+	return w.db.Accounts.ImportStaged(accounts)
 }
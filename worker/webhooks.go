@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/figment-networks/mina-indexer/config"
+	"github.com/figment-networks/mina-indexer/model"
+	"github.com/figment-networks/mina-indexer/store"
+)
+
+const (
+	webhookBatchSize = 100
+	webhookTimeout   = 10 * time.Second
+)
+
+var (
+	errWebhookRequest = errors.New("webhook request failed")
+	errWebhookTimeout = errors.New("webhook request timed out")
+)
+
+// NewWebhookClient returns a HTTP client used for webhook deliveries
+func NewWebhookClient() *http.Client {
+	return &http.Client{Timeout: webhookTimeout}
+}
+
+// RunWebhooks delivers pending webhook payloads to the subscribed endpoints
+func RunWebhooks(cfg *config.Config, db *store.Store, client *http.Client) error {
+	if err := db.Webhooks.CancelOrphaned(); err != nil {
+		return err
+	}
+
+	deliveries, err := db.Webhooks.PendingDeliveries(time.Now(), webhookBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	subscriptions := map[int]*model.WebhookSubscription{}
+
+	for _, delivery := range deliveries {
+		sub, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			sub, err = db.Webhooks.FindSubscription(delivery.SubscriptionID)
+			if err != nil {
+				log.
+					WithField("id", delivery.ID).
+					WithField("subscription", delivery.SubscriptionID).
+					WithError(err).
+					Error("webhook subscription fetch failed")
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = sub
+		}
+
+		code, err := deliverWebhook(client, sub, &delivery)
+		if err != nil {
+			delivery.Fail(code, err, time.Now())
+
+			log.
+				WithField("id", delivery.ID).
+				WithField("attempts", delivery.Attempts).
+				WithError(err).
+				Warn("webhook delivery failed")
+		} else {
+			delivery.Succeed(code, time.Now())
+		}
+
+		if err := db.Webhooks.UpdateDelivery(&delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deliverWebhook(client *http.Client, sub *model.WebhookSubscription, delivery *model.WebhookDelivery) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, errWebhookRequest
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mina-indexer")
+	req.Header.Set("X-Webhook-Event", delivery.Event)
+	req.Header.Set("X-Webhook-Delivery", strconv.Itoa(delivery.ID))
+	req.Header.Set("X-Webhook-Signature", "sha256="+model.SignWebhookPayload(sub.Secret, delivery.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, webhookRequestError(err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// webhookRequestError hides the transport error details, they include the subscriber URL
+func webhookRequestError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok && urlErr.Timeout() {
+		return errWebhookTimeout
+	}
+	return errWebhookRequest
+}